@@ -0,0 +1,129 @@
+package validateachangelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+var breakingEntryRegex = regexp.MustCompile(`(?i)^(?:\*\*breaking(?: changes?)?:?\*\*|__breaking(?: changes?)?:?__|\[breaking(?: changes?)?\]|breaking(?: changes?)?:)`)
+
+// BumpRules maps change types to the version bump they require
+type BumpRules map[string]Bump
+
+// DefaultBumpRules returns the bump required by the standard change types
+func DefaultBumpRules() BumpRules {
+	return BumpRules{
+		"Added":      BumpMinor,
+		"Changed":    BumpMinor,
+		"Deprecated": BumpMinor,
+		"Removed":    BumpMajor,
+		"Fixed":      BumpPatch,
+		"Security":   BumpPatch,
+	}
+}
+
+// Get returns the bump required by the change type (unknown change types require a patch bump)
+func (r BumpRules) Get(changeType string) Bump {
+	if bump, exists := r[changeType]; exists {
+		return bump
+	}
+
+	return BumpPatch
+}
+
+// IsBreaking returns true if the entry is marked as a breaking change (e.g. `**BREAKING**`, `[BREAKING]` or
+// `BREAKING CHANGE:` prefix)
+func (e *Entry) IsBreaking() bool {
+	return breakingEntryRegex.MatchString(strings.TrimSpace(e.Description))
+}
+
+// RequiredBump returns the bump required by the version entries. Breaking entries require a major bump, and rules
+// default to DefaultBumpRules when nil.
+func (v *Version) RequiredBump(rules BumpRules) Bump {
+	if rules == nil {
+		rules = DefaultBumpRules()
+	}
+
+	bump := BumpNone
+
+	for _, changeType := range v.Entries.Keys() {
+		entries, _ := v.Entries.Get(changeType)
+
+		WalkEntries(entries, func(entry *Entry, _ int) {
+			required := rules.Get(changeType)
+			if entry.IsBreaking() {
+				required = BumpMajor
+			}
+
+			bump = max(bump, required)
+		})
+	}
+
+	return bump
+}
+
+// EffectiveBump returns the bump to apply to the version: major bumps are downgraded to minor ones while the major
+// version is 0 (initial development)
+func EffectiveBump(version string, bump Bump) Bump {
+	if bump == BumpMajor && strings.HasPrefix(version, "0.") {
+		return BumpMinor
+	}
+
+	return bump
+}
+
+// BumpBetween returns the bump applied between two versions (pre-release and build metadata are ignored)
+func BumpBetween(previous, next string) (Bump, error) {
+	p, ok := internal.ParseSemVer(previous)
+	if !ok {
+		return BumpNone, fmt.Errorf("invalid version: %s", previous)
+	}
+
+	n, ok := internal.ParseSemVer(next)
+	if !ok {
+		return BumpNone, fmt.Errorf("invalid version: %s", next)
+	}
+
+	switch {
+	case n.Major != p.Major:
+		return BumpMajor, nil
+	case n.Minor != p.Minor:
+		return BumpMinor, nil
+	case n.Patch != p.Patch:
+		return BumpPatch, nil
+	default:
+		return BumpNone, nil
+	}
+}
+
+// SuggestNextVersion suggests the next version from the latest release and the Unreleased entries. It returns the
+// next version and the applied bump.
+func (c *Changelog) SuggestNextVersion(rules BumpRules) (string, Bump, error) {
+	unreleased := c.Unreleased()
+	if unreleased == nil {
+		return "", BumpNone, fmt.Errorf("no %s version found in changelog", UnreleasedVersion)
+	}
+
+	bump := unreleased.RequiredBump(rules)
+	if bump == BumpNone {
+		return "", BumpNone, fmt.Errorf("nothing to release: %s version is empty", UnreleasedVersion)
+	}
+
+	// Start from 0.0.0 when nothing has been released yet
+	latestVersion := "0.0.0"
+	if latestRelease := c.LatestRelease(); latestRelease != nil {
+		latestVersion = latestRelease.Version
+	}
+
+	bump = EffectiveBump(latestVersion, bump)
+
+	next, err := NextVersion(latestVersion, bump)
+	if err != nil {
+		return "", BumpNone, err
+	}
+
+	return next, bump, nil
+}
@@ -0,0 +1,157 @@
+package validateachangelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+func newVersion(version string, entries map[string][]Entry, keys ...string) *Version {
+	return &Version{
+		Version: version,
+		Entries: *internal.NewSortedMap(keys, entries),
+	}
+}
+
+func TestEntry_IsBreaking(t *testing.T) {
+	cases := []struct {
+		Description string
+		IsBreaking  bool
+	}{
+		{Description: "**BREAKING**: drop Go 1.22 support.", IsBreaking: true},
+		{Description: "**Breaking:** drop Go 1.22 support.", IsBreaking: true},
+		{Description: "[BREAKING] drop Go 1.22 support.", IsBreaking: true},
+		{Description: "BREAKING CHANGE: drop Go 1.22 support.", IsBreaking: true},
+		{Description: "breaking: drop Go 1.22 support.", IsBreaking: true},
+		{Description: "Breaking news parser.", IsBreaking: false},
+		{Description: "Fix breaking change in parser.", IsBreaking: false},
+	}
+
+	for _, c := range cases {
+		entry := Entry{Description: c.Description}
+		if entry.IsBreaking() != c.IsBreaking {
+			t.Logf("IsBreaking(%s). Got %v, wanted %v", c.Description, !c.IsBreaking, c.IsBreaking)
+			t.Fail()
+		}
+	}
+}
+
+func TestVersion_RequiredBump(t *testing.T) {
+	cases := []struct {
+		Version *Version
+		Rules   BumpRules
+		Bump    Bump
+	}{
+		{
+			Version: newVersion("Unreleased", map[string][]Entry{}),
+			Bump:    BumpNone,
+		},
+		{
+			Version: newVersion("Unreleased", map[string][]Entry{"Fixed": {{Description: "Fix."}}, "Security": {{Description: "Fix."}}}, "Fixed", "Security"),
+			Bump:    BumpPatch,
+		},
+		{
+			Version: newVersion("Unreleased", map[string][]Entry{"Added": {{Description: "Feature."}}, "Fixed": {{Description: "Fix."}}}, "Added", "Fixed"),
+			Bump:    BumpMinor,
+		},
+		{
+			Version: newVersion("Unreleased", map[string][]Entry{"Removed": {{Description: "Legacy API."}}}, "Removed"),
+			Bump:    BumpMajor,
+		},
+		{
+			Version: newVersion("Unreleased", map[string][]Entry{"Fixed": {{Description: "Fix.", Children: []Entry{{Description: "**BREAKING**: rename option."}}}}}, "Fixed"),
+			Bump:    BumpMajor,
+		},
+		{
+			Version: newVersion("Unreleased", map[string][]Entry{"Performance": {{Description: "Faster."}}}, "Performance"),
+			Bump:    BumpPatch,
+		},
+		{
+			Version: newVersion("Unreleased", map[string][]Entry{"Performance": {{Description: "Faster."}}}, "Performance"),
+			Rules:   BumpRules{"Performance": BumpMinor},
+			Bump:    BumpMinor,
+		},
+	}
+
+	for i, c := range cases {
+		if bump := c.Version.RequiredBump(c.Rules); bump != c.Bump {
+			t.Logf("RequiredBump() case %d. Got %s, wanted %s", i, bump, c.Bump)
+			t.Fail()
+		}
+	}
+}
+
+func TestChangelog_SuggestNextVersion(t *testing.T) {
+	releaseDate := time.Date(2025, 10, 28, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Latest  string
+		Entries map[string][]Entry
+		Keys    []string
+		Next    string
+		Bump    Bump
+	}{
+		{Latest: "1.2.3", Entries: map[string][]Entry{"Fixed": {{Description: "Fix."}}}, Keys: []string{"Fixed"}, Next: "1.2.4", Bump: BumpPatch},
+		{Latest: "1.2.3", Entries: map[string][]Entry{"Deprecated": {{Description: "Old API."}}}, Keys: []string{"Deprecated"}, Next: "1.3.0", Bump: BumpMinor},
+		{Latest: "1.2.3", Entries: map[string][]Entry{"Removed": {{Description: "Old API."}}}, Keys: []string{"Removed"}, Next: "2.0.0", Bump: BumpMajor},
+		{Latest: "0.5.2", Entries: map[string][]Entry{"Removed": {{Description: "Old API."}}}, Keys: []string{"Removed"}, Next: "0.6.0", Bump: BumpMinor},
+		{Latest: "", Entries: map[string][]Entry{"Added": {{Description: "Initial release."}}}, Keys: []string{"Added"}, Next: "0.1.0", Bump: BumpMinor},
+	}
+
+	for _, tc := range cases {
+		c := &Changelog{Versions: []*Version{newVersion("Unreleased", tc.Entries, tc.Keys...)}}
+		if tc.Latest != "" {
+			c.Versions = append(c.Versions, &Version{Version: tc.Latest, ReleaseDate: &releaseDate})
+		}
+
+		next, bump, err := c.SuggestNextVersion(nil)
+		if err != nil || next != tc.Next || bump != tc.Bump {
+			t.Logf("SuggestNextVersion() from %s. Got (%s, %s, %v), wanted (%s, %s)", tc.Latest, next, bump, err, tc.Next, tc.Bump)
+			t.Fail()
+		}
+	}
+
+	c := &Changelog{Versions: []*Version{newVersion("Unreleased", map[string][]Entry{})}}
+	if _, _, err := c.SuggestNextVersion(nil); err == nil {
+		t.Fatal("SuggestNextVersion() should fail on empty Unreleased version")
+	}
+}
+
+func TestBumpBetween(t *testing.T) {
+	cases := []struct {
+		Previous string
+		Next     string
+		Bump     Bump
+	}{
+		{Previous: "1.4.0", Next: "1.4.1", Bump: BumpPatch},
+		{Previous: "1.4.1", Next: "1.5.0", Bump: BumpMinor},
+		{Previous: "1.5.0", Next: "2.0.0", Bump: BumpMajor},
+		{Previous: "2.0.0-rc.1", Next: "2.0.0", Bump: BumpNone},
+		{Previous: "1.9.0", Next: "2.0.0-rc.1", Bump: BumpMajor},
+	}
+
+	for _, c := range cases {
+		bump, err := BumpBetween(c.Previous, c.Next)
+		if err != nil || bump != c.Bump {
+			t.Logf("BumpBetween(%s, %s). Got (%s, %v), wanted %s", c.Previous, c.Next, bump, err, c.Bump)
+			t.Fail()
+		}
+	}
+
+	if _, err := BumpBetween("Unreleased", "1.0.0"); err == nil {
+		t.Fatal("BumpBetween() should fail on invalid version")
+	}
+}
+
+func TestEffectiveBump(t *testing.T) {
+	if EffectiveBump("0.5.0", BumpMajor) != BumpMinor {
+		t.Fatal("Expected major bump to be downgraded while major version is 0")
+	}
+	if EffectiveBump("1.5.0", BumpMajor) != BumpMajor {
+		t.Fatal("Expected major bump to be kept")
+	}
+	if EffectiveBump("0.5.0", BumpPatch) != BumpPatch {
+		t.Fatal("Expected patch bump to be kept")
+	}
+}
@@ -0,0 +1,92 @@
+package validateachangelog
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+// ChangeTypes maps the allowed change types (sections) to their weight, sections being sorted by ascending weight
+type ChangeTypes map[string]int
+
+// DefaultChangeTypes returns the Keep a Changelog change types (Added, Changed, Deprecated, Removed, Fixed, Security)
+func DefaultChangeTypes() ChangeTypes {
+	return internal.GetStandardChangeTypes()
+}
+
+// NewChangeTypes returns the change types weighted following the given order
+func NewChangeTypes(changeTypes ...string) ChangeTypes {
+	ct := ChangeTypes{}
+
+	for _, changeType := range changeTypes {
+		if _, exists := ct[changeType]; !exists {
+			ct[changeType] = len(ct)
+		}
+	}
+
+	return ct
+}
+
+// ParseChangeTypes parses a comma separated list of change types (e.g. `Added,Changed,Performance`)
+func ParseChangeTypes(s string) ChangeTypes {
+	var changeTypes []string
+
+	for _, changeType := range strings.Split(s, ",") {
+		if changeType = strings.TrimSpace(changeType); changeType != "" {
+			changeTypes = append(changeTypes, changeType)
+		}
+	}
+
+	return NewChangeTypes(changeTypes...)
+}
+
+// Has returns true if the change type is allowed
+func (ct ChangeTypes) Has(changeType string) bool {
+	_, exists := ct[changeType]
+
+	return exists
+}
+
+// Weight returns the weight of the change type (unknown change types being the heaviest)
+func (ct ChangeTypes) Weight(changeType string) int {
+	if weight, exists := ct[changeType]; exists {
+		return weight
+	}
+
+	return math.MaxInt
+}
+
+// Names returns the change types sorted by weight
+func (ct ChangeTypes) Names() []string {
+	names := make([]string, 0, len(ct))
+	for changeType := range ct {
+		names = append(names, changeType)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if ct[names[i]] != ct[names[j]] {
+			return ct[names[i]] < ct[names[j]]
+		}
+
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+// Normalize returns the allowed change type matching (case-insensitive) the given one, or the given one if none does
+func (ct ChangeTypes) Normalize(changeType string) string {
+	if ct.Has(changeType) {
+		return changeType
+	}
+
+	for _, name := range ct.Names() {
+		if strings.EqualFold(name, changeType) {
+			return name
+		}
+	}
+
+	return changeType
+}
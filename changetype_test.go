@@ -0,0 +1,52 @@
+package validateachangelog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewChangeTypes(t *testing.T) {
+	ct := NewChangeTypes("Breaking", "Added", "Performance", "Added")
+
+	if names := strings.Join(ct.Names(), ","); names != "Breaking,Added,Performance" {
+		t.Fatalf("Unexpected names: %s", names)
+	}
+
+	if ct.Weight("Performance") != 2 || ct.Weight("Fixed") <= ct.Weight("Performance") {
+		t.Fatal("Unexpected weights")
+	}
+}
+
+func TestDefaultChangeTypes(t *testing.T) {
+	ct := DefaultChangeTypes()
+
+	if names := strings.Join(ct.Names(), ","); names != "Added,Changed,Deprecated,Removed,Fixed,Security" {
+		t.Fatalf("Unexpected names: %s", names)
+	}
+}
+
+func TestChangeTypesNormalize(t *testing.T) {
+	ct := DefaultChangeTypes()
+
+	cases := map[string]string{
+		"fixed":       "Fixed",
+		"SECURITY":    "Security",
+		"Added":       "Added",
+		"Performance": "Performance",
+	}
+
+	for changeType, wanted := range cases {
+		if got := ct.Normalize(changeType); got != wanted {
+			t.Logf("Normalize(%s). Got: %s, wanted: %s", changeType, got, wanted)
+			t.Fail()
+		}
+	}
+}
+
+func TestParseChangeTypes(t *testing.T) {
+	ct := ParseChangeTypes(" Added, Performance,,Fixed ")
+
+	if names := strings.Join(ct.Names(), ","); names != "Added,Performance,Fixed" {
+		t.Fatalf("Unexpected names: %s", names)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/config"
+	"github.com/vold-lu/validate-a-changelog/parser"
+	"github.com/vold-lu/validate-a-changelog/writer"
+)
+
+func main() {
+	// Flags
+	version := flag.String("version", validateachangelog.UnreleasedVersion, "version to add the entry to")
+	changeTypes := flag.String("change-types", "", "comma separated list of the allowed change types, in order (default: Added,Changed,Deprecated,Removed,Fixed,Security)")
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the changelog directory)")
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	// Args
+	if len(args) < 3 {
+		fmt.Println("Usage: add-changelog [-version <version>] [-change-types <list>] [-config <file>] <file> <change type> <description>")
+		os.Exit(1)
+	}
+
+	changelogFile := args[0]
+
+	c, err := parser.ParseFile(changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Resolve(*configFile, changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	writerOpts := cfg.WriterOptions()
+
+	// Flags take precedence over the configuration
+	if *changeTypes != "" {
+		writerOpts.ChangeTypes = validateachangelog.ParseChangeTypes(*changeTypes)
+	}
+
+	if err := c.AddEntryWithChangeTypes(*version, args[1], strings.Join(args[2:], " "), writerOpts.ChangeTypes); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := writer.WriteFile(changelogFile, c, writerOpts); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
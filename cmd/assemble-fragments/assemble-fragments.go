@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/config"
+	"github.com/vold-lu/validate-a-changelog/fragment"
+	"github.com/vold-lu/validate-a-changelog/parser"
+	"github.com/vold-lu/validate-a-changelog/writer"
+)
+
+func main() {
+	// Flags
+	dir := flag.String("dir", fragment.DefaultDirectory, "fragments directory")
+	version := flag.String("version", "", "release the assembled entries as a new version (instead of keeping them Unreleased)")
+	date := flag.String("date", time.Now().Format("2006-01-02"), "release date (YYYY-MM-DD) used with -version")
+	changeTypes := flag.String("change-types", "", "comma separated list of the allowed change types, in order (default: Added,Changed,Deprecated,Removed,Fixed,Security)")
+	keep := flag.Bool("keep", false, "keep the fragment files once assembled")
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the changelog directory)")
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	// Args
+	if len(args) < 1 {
+		fmt.Println("Usage: assemble-fragments [-dir <directory>] [-version <version>] [-date YYYY-MM-DD] [-change-types <list>] [-keep] [-config <file>] <file>")
+		os.Exit(1)
+	}
+
+	changelogFile := args[0]
+
+	c, err := parser.ParseFile(changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Resolve(*configFile, changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	writerOpts := cfg.WriterOptions()
+
+	// Flags take precedence over the configuration
+	if *changeTypes != "" {
+		writerOpts.ChangeTypes = validateachangelog.ParseChangeTypes(*changeTypes)
+	}
+
+	fragments, err := fragment.Load(*dir)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if len(fragments) == 0 {
+		fmt.Println("no fragments found in " + *dir)
+		os.Exit(1)
+	}
+
+	if err := fragment.Assemble(c, fragments, validateachangelog.UnreleasedVersion, &fragment.Options{ChangeTypes: writerOpts.ChangeTypes}); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Promote the Unreleased entries to a new version
+	if *version != "" {
+		releaseDate, err := time.Parse("2006-01-02", *date)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		if err := c.Release(*version, releaseDate); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		// Add the missing version links
+		c.GenerateLinks(cfg.Links, cfg.TagPattern)
+	}
+
+	if err := writer.WriteFile(changelogFile, c, writerOpts); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if !*keep {
+		if err := fragment.Remove(fragments); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+}
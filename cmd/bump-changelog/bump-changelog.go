@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/config"
+	"github.com/vold-lu/validate-a-changelog/parser"
+)
+
+type suggestion struct {
+	Current string `json:"current"`
+	Next    string `json:"next"`
+	Bump    string `json:"bump"`
+}
+
+func main() {
+	rules := validateachangelog.BumpRules{}
+
+	// Flags
+	flag.Func("bump-rule", "bump required by a change type, e.g. Performance=patch (can be repeated)", func(s string) error {
+		changeType, bump, found := strings.Cut(s, "=")
+		if !found {
+			return fmt.Errorf("invalid bump rule: %s (expected <change type>=<bump>)", s)
+		}
+
+		b, err := validateachangelog.ParseBump(bump)
+		if err != nil {
+			return err
+		}
+
+		rules[changeType] = b
+
+		return nil
+	})
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the changelog directory)")
+	jsonOutput := flag.Bool("json", false, "output suggestion as json")
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	// Args
+	if len(args) < 1 {
+		fmt.Println("Usage: bump-changelog [-bump-rule <change type>=<bump>]... [-config <file>] [-json] <file>")
+		os.Exit(1)
+	}
+
+	c, err := parser.ParseFile(args[0])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Resolve(*configFile, args[0])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Flags take precedence over the configuration
+	bumpRules := cfg.GetBumpRules()
+	for changeType, bump := range rules {
+		bumpRules[changeType] = bump
+	}
+
+	next, bump, err := c.SuggestNextVersion(bumpRules)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if *jsonOutput {
+		s := suggestion{
+			Next: next,
+			Bump: bump.String(),
+		}
+
+		if latestRelease := c.LatestRelease(); latestRelease != nil {
+			s.Current = latestRelease.Version
+		}
+
+		if err := json.NewEncoder(os.Stdout).Encode(s); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	} else {
+		fmt.Println(next)
+	}
+}
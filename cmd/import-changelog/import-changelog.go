@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/config"
+	"github.com/vold-lu/validate-a-changelog/importer"
+	"github.com/vold-lu/validate-a-changelog/parser"
+	"github.com/vold-lu/validate-a-changelog/writer"
+)
+
+func main() {
+	types := importer.DefaultTypes()
+
+	// Flags
+	repository := flag.String("repository", ".", "git repository to read the commits from")
+	tagPattern := flag.String("tag-pattern", validateachangelog.DefaultTagPattern, "version tags naming ({version} being replaced by the version)")
+	flag.Func("type", "section of a commit type, e.g. docs=Changed (can be repeated)", func(s string) error {
+		commitType, section, found := strings.Cut(s, "=")
+		if !found {
+			return fmt.Errorf("invalid type: %s (expected <commit type>=<section>)", s)
+		}
+
+		types[strings.ToLower(commitType)] = section
+
+		return nil
+	})
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the changelog directory)")
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	// Args
+	if len(args) < 1 {
+		fmt.Println("Usage: import-changelog [-repository <directory>] [-tag-pattern <pattern>] [-type <commit type>=<section>]... [-config <file>] <file>")
+		os.Exit(1)
+	}
+
+	changelogFile := args[0]
+
+	c, err := parser.ParseFile(changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Resolve(*configFile, changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	opts := &importer.Options{
+		TagPattern: cfg.TagPattern,
+		Types:      types,
+	}
+
+	// Flags take precedence over the configuration
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "tag-pattern" {
+			opts.TagPattern = *tagPattern
+		}
+	})
+
+	imported, err := importer.Import(c, *repository, opts)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if imported > 0 {
+		if err := writer.WriteFile(changelogFile, c, cfg.WriterOptions()); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Println(imported)
+}
@@ -7,12 +7,27 @@ import (
 	"os"
 	"strings"
 
-	"github.com/vold-lu/validate-a-changelog/internal"
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/config"
 	"github.com/vold-lu/validate-a-changelog/linter"
+	"github.com/vold-lu/validate-a-changelog/writer"
 )
 
 func main() {
 	// Flags
+	changeTypes := flag.String("change-types", "", "comma separated list of the allowed change types, in order (default: Added,Changed,Deprecated,Removed,Fixed,Security)")
+	aliases := map[string]string{}
+	flag.Func("alias", "change type a section is renamed to, e.g. Perf=Performance (can be repeated)", func(s string) error {
+		section, changeType, found := strings.Cut(s, "=")
+		if !found {
+			return fmt.Errorf("invalid alias: %s (expected <section>=<change type>)", s)
+		}
+
+		aliases[section] = changeType
+
+		return nil
+	})
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the changelog directory)")
 	jsonOutput := flag.Bool("json", false, "output validation issues as json")
 
 	flag.Parse()
@@ -21,69 +36,48 @@ func main() {
 
 	// Args
 	if len(args) < 1 {
-		fmt.Println("Usage: lint-changelog [-json] <file>")
+		fmt.Println("Usage: lint-changelog [-change-types <list>] [-alias <section>=<change type>]... [-config <file>] [-json] <file>")
 		os.Exit(1)
 	}
 
-	c, err := linter.LintFile(args[0])
+	cfg, err := config.Resolve(*configFile, args[0])
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
-	standardChangeTypes := internal.GetStandardChangeTypes()
+	opts := cfg.LinterOptions()
+	writerOpts := cfg.WriterOptions()
+	writerOpts.SortSections = true
 
-	// Reverse map the standard change types by their weight
-	sortedStandardChangeTypes := make([]string, len(standardChangeTypes))
-	for k, v := range standardChangeTypes {
-		sortedStandardChangeTypes[v] = k
+	// Flags take precedence over the configuration
+	if *changeTypes != "" {
+		opts.ChangeTypes = validateachangelog.ParseChangeTypes(*changeTypes)
+		writerOpts.ChangeTypes = opts.ChangeTypes
 	}
 
+	for section, changeType := range aliases {
+		opts.Aliases[section] = changeType
+	}
+
+	c, err := linter.LintFile(args[0], opts)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Add the missing version links
+	c.GenerateLinks(cfg.Links, cfg.TagPattern)
+
 	if *jsonOutput {
 		if err := json.NewEncoder(os.Stdout).Encode(c); err != nil {
 			fmt.Println(err)
 			os.Exit(1)
 		}
 	} else {
-		// Handle title (if any)
-		if c.Title != "" {
-			fmt.Printf("# %s\n\n", c.Title)
-		}
-
-		for _, v := range c.Versions {
-			var sb strings.Builder
-
-			// Handle version line
-			sb.WriteString("## [")
-			sb.WriteString(v.Version)
-			sb.WriteString("]")
-
-			if v.ReleaseDate != nil {
-				sb.WriteString(" - ")
-				sb.WriteString(v.ReleaseDate.Format("2006-01-02"))
-			}
-
-			sb.WriteString("\n\n")
-
-			// Handle section (sorted)
-			for _, changeType := range sortedStandardChangeTypes {
-				if entries, exists := v.Entries.Get(changeType); exists {
-					// Handle section line
-					sb.WriteString("### ")
-					sb.WriteString(changeType)
-					sb.WriteString("\n\n")
-
-					// Handle entries
-					for _, entry := range entries {
-						sb.WriteString("- ")
-						sb.WriteString(entry.Description)
-						sb.WriteString("\n")
-					}
-					sb.WriteString("\n")
-				}
-			}
-
-			fmt.Print(sb.String())
+		if err := writer.Write(os.Stdout, c, writerOpts); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
 		}
 	}
 }
@@ -2,31 +2,62 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"os"
 
-	validateachangelog "github.com/vold-lu/validate-a-changelog/parser"
+	"github.com/vold-lu/validate-a-changelog/config"
+	"github.com/vold-lu/validate-a-changelog/parser"
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: parse-changelog <file> [version]")
+	// Flags
+	excludeYanked := flag.Bool("exclude-yanked", false, "exclude yanked versions from output")
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the changelog directory)")
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	// Args
+	if len(args) < 1 {
+		fmt.Println("Usage: parse-changelog [-exclude-yanked] [-config <file>] <file> [version]")
 		os.Exit(1)
 	}
 
-	changelogFile := os.Args[1]
+	changelogFile := args[0]
 	version := ""
 
-	if len(os.Args) > 2 {
-		version = os.Args[2]
+	if len(args) > 1 {
+		version = args[1]
 	}
 
-	c, err := validateachangelog.ParseFile(changelogFile)
+	c, err := parser.ParseFile(changelogFile)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
+	cfg, err := config.Resolve(*configFile, changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Resolve the URL of the versions without link from the configured templates
+	c.GenerateLinks(cfg.Links, cfg.TagPattern)
+
+	// Filter out yanked versions
+	if *excludeYanked {
+		versions := c.Versions[:0]
+		for _, v := range c.Versions {
+			if !v.Yanked {
+				versions = append(versions, v)
+			}
+		}
+		c.Versions = versions
+	}
+
 	// Output the whole changelog
 	if version == "" {
 		if err := json.NewEncoder(os.Stdout).Encode(c); err != nil {
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/config"
+	"github.com/vold-lu/validate-a-changelog/parser"
+	"github.com/vold-lu/validate-a-changelog/writer"
+)
+
+func main() {
+	// Flags
+	bump := flag.String("bump", "", "compute the version from the latest release (major, minor, patch or auto)")
+	date := flag.String("date", time.Now().Format("2006-01-02"), "release date (YYYY-MM-DD)")
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the changelog directory)")
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	// Args
+	if len(args) < 1 || (len(args) < 2 && *bump == "") {
+		fmt.Println("Usage: release-changelog [-bump major|minor|patch|auto] [-date YYYY-MM-DD] [-config <file>] <file> [version]")
+		os.Exit(1)
+	}
+
+	changelogFile := args[0]
+
+	c, err := parser.ParseFile(changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	cfg, err := config.Resolve(*configFile, changelogFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	releaseDate, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	version := ""
+	if len(args) > 1 {
+		version = args[1]
+	} else if *bump == "auto" {
+		// Suggest the version from the Unreleased change types
+		version, _, err = c.SuggestNextVersion(cfg.GetBumpRules())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	} else {
+		b, err := validateachangelog.ParseBump(*bump)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		// Start from 0.0.0 when nothing has been released yet
+		latestVersion := "0.0.0"
+		if latestRelease := c.LatestRelease(); latestRelease != nil {
+			latestVersion = latestRelease.Version
+		}
+
+		version, err = validateachangelog.NextVersion(latestVersion, b)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+
+	if err := c.Release(version, releaseDate); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Add the missing version links
+	c.GenerateLinks(cfg.Links, cfg.TagPattern)
+
+	if err := writer.WriteFile(changelogFile, c, cfg.WriterOptions()); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Println(version)
+}
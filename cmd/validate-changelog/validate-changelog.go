@@ -5,7 +5,12 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/config"
+	"github.com/vold-lu/validate-a-changelog/internal"
 	"github.com/vold-lu/validate-a-changelog/parser"
 	"github.com/vold-lu/validate-a-changelog/validator"
 )
@@ -16,6 +21,30 @@ func main() {
 	allowMissingReleaseDate := flag.Bool("allow-missing-release-date", false, "allow version without release date")
 	allowInvalidChangeType := flag.Bool("allow-invalid-change-type", false, "allow section with invalid change type")
 	allowInvalidChangeTypeOrder := flag.Bool("allow-invalid-change-type-order", false, "allow section with invalid change type ordering")
+	changeTypes := flag.String("change-types", "", "comma separated list of the allowed change types, in order (default: Added,Changed,Deprecated,Removed,Fixed,Security)")
+	checkMissingLink := flag.Bool("check-missing-link", false, "report version without link reference definition")
+	checkOrphanLink := flag.Bool("check-orphan-link", false, "report link reference definition without version")
+	checkCompareLink := flag.Bool("check-compare-link", false, "report compare link not matching the neighbouring versions")
+	checkSemVerBump := flag.Bool("check-semver-bump", false, "report version whose bump is too small for its entries")
+	repository := flag.String("repository", "", "cross-check the releases against the version tags of the git repository")
+	tagPattern := flag.String("tag-pattern", validateachangelog.DefaultTagPattern, "version tags naming ({version} being replaced by the version)")
+	manifest := flag.String("manifest", "", "check the latest release against the version of the manifest (package.json, Cargo.toml, VERSION...)")
+	rules := map[string]validator.Severity{}
+	flag.Func("rule", "severity of a rule, e.g. CL004=warning or unsorted-change-type=off (can be repeated)", func(s string) error {
+		id, severity, err := validator.ParseRuleSeverity(s)
+		if err != nil {
+			return err
+		}
+
+		rules[id] = severity
+
+		return nil
+	})
+	baseline := flag.String("baseline", "", "file of the accepted issues, which are not reported")
+	updateBaseline := flag.Bool("update-baseline", false, "write the current issues to the baseline file")
+	base := flag.String("base", "", "base changelog file: only report the issues of the versions added or modified since")
+	baseRef := flag.String("base-ref", "", "git revision of the base changelog (e.g. origin/main), read from the changelog repository")
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the changelog directory)")
 	jsonOutput := flag.Bool("json", false, "output validation issues as json")
 
 	flag.Parse()
@@ -24,7 +53,7 @@ func main() {
 
 	// Args
 	if len(args) < 1 {
-		fmt.Println("Usage: validate-changelog [-allow-empty-version] [-allow-missing-release-date] [-allow-invalid-change-type] [-allow-invalid-change-type-order] [-json] <file>")
+		fmt.Println("Usage: validate-changelog [-allow-empty-version] [-allow-missing-release-date] [-allow-invalid-change-type] [-allow-invalid-change-type-order] [-change-types <list>] [-check-missing-link] [-check-orphan-link] [-check-compare-link] [-check-semver-bump] [-repository <directory>] [-tag-pattern <pattern>] [-manifest <file>] [-rule <rule>=<severity>]... [-baseline <file>] [-update-baseline] [-base <file> | -base-ref <revision>] [-config <file>] [-json] <file>")
 		os.Exit(1)
 	}
 
@@ -34,22 +63,117 @@ func main() {
 		os.Exit(1)
 	}
 
-	opts := &validator.Options{
-		AllowEmptyVersion:           *allowEmptyVersion,
-		AllowMissingReleaseDate:     *allowMissingReleaseDate,
-		AllowInvalidChangeType:      *allowInvalidChangeType,
-		AllowInvalidChangeTypeOrder: *allowInvalidChangeTypeOrder,
+	cfg, err := config.Resolve(*configFile, args[0])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	opts := cfg.ValidatorOptions()
+
+	// Flags take precedence over the configuration
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "allow-empty-version":
+			opts.AllowEmptyVersion = *allowEmptyVersion
+		case "allow-missing-release-date":
+			opts.AllowMissingReleaseDate = *allowMissingReleaseDate
+		case "allow-invalid-change-type":
+			opts.AllowInvalidChangeType = *allowInvalidChangeType
+		case "allow-invalid-change-type-order":
+			opts.AllowInvalidChangeTypeOrder = *allowInvalidChangeTypeOrder
+		case "change-types":
+			opts.ChangeTypes = validateachangelog.ParseChangeTypes(*changeTypes)
+		case "check-missing-link":
+			opts.CheckMissingLink = *checkMissingLink
+		case "check-orphan-link":
+			opts.CheckOrphanLink = *checkOrphanLink
+		case "check-compare-link":
+			opts.CheckCompareLink = *checkCompareLink
+		case "check-semver-bump":
+			opts.CheckSemVerBump = *checkSemVerBump
+		case "repository":
+			opts.Repository = *repository
+		case "tag-pattern":
+			opts.TagPattern = *tagPattern
+		case "manifest":
+			opts.Manifest = *manifest
+		case "baseline":
+			cfg.Validator.Baseline = *baseline
+		}
+	})
+
+	for id, severity := range rules {
+		if opts.Rules == nil {
+			opts.Rules = map[string]validator.Severity{}
+		}
+		opts.Rules[id] = severity
+	}
+
+	switch {
+	case *base != "":
+		opts.Base, err = parser.ParseFile(*base)
+	case *baseRef != "":
+		opts.Base, err = parseRevision(*baseRef, args[0])
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if *updateBaseline {
+		if cfg.Validator.Baseline == "" {
+			fmt.Println("-update-baseline requires a baseline file (-baseline)")
+			os.Exit(1)
+		}
+
+		var issues []validator.ValidationIssue
+		if err := validator.Validate(c, opts); err != nil {
+			issues = err.(*validator.ValidationError).Issues
+		}
+
+		if err := validator.NewBaseline(issues).Save(cfg.Validator.Baseline); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("%d issue(s) written to %s\n", len(issues), cfg.Validator.Baseline)
+		return
+	}
+
+	if cfg.Validator.Baseline != "" {
+		opts.Baseline, err = validator.LoadBaseline(cfg.Validator.Baseline)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 	}
 
 	if err := validator.Validate(c, opts); err != nil {
+		validationErr := err.(*validator.ValidationError)
+
 		if *jsonOutput {
-			if err := json.NewEncoder(os.Stdout).Encode(err.(*validator.ValidationError)); err != nil {
+			if err := json.NewEncoder(os.Stdout).Encode(validationErr); err != nil {
 				fmt.Println(err)
 				os.Exit(1)
 			}
 		} else {
 			fmt.Println(err)
-			os.Exit(1)
+
+			// Warnings and infos do not fail the validation
+			if validationErr.HasErrors() {
+				os.Exit(1)
+			}
 		}
 	}
 }
+
+// parseRevision parses the changelog file as of the git revision
+func parseRevision(revision, filename string) (*validateachangelog.Changelog, error) {
+	content, err := internal.RunGit(filepath.Dir(filename), "show", revision+":./"+filepath.Base(filename))
+	if err != nil {
+		return nil, err
+	}
+
+	return parser.Parse(strings.NewReader(content))
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/config"
+	"github.com/vold-lu/validate-a-changelog/fragment"
+)
+
+func main() {
+	// Flags
+	changeTypes := flag.String("change-types", "", "comma separated list of the allowed change types, in order (default: Added,Changed,Deprecated,Removed,Fixed,Security)")
+	configFile := flag.String("config", "", "configuration file (default: .changelog.yaml discovered from the fragments directory)")
+	jsonOutput := flag.Bool("json", false, "output validation issues as json")
+
+	flag.Parse()
+
+	args := flag.Args()
+
+	// Args
+	dir := fragment.DefaultDirectory
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	var cfg *config.Config
+	var err error
+	if *configFile != "" {
+		cfg, err = config.Load(*configFile)
+	} else {
+		cfg, err = config.Discover(dir)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	allowedChangeTypes := cfg.GetChangeTypes()
+
+	// Flags take precedence over the configuration
+	if *changeTypes != "" {
+		allowedChangeTypes = validateachangelog.ParseChangeTypes(*changeTypes)
+	}
+
+	fragments, err := fragment.Load(dir)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := fragment.Validate(fragments, &fragment.Options{ChangeTypes: allowedChangeTypes}); err != nil {
+		if *jsonOutput {
+			if err := json.NewEncoder(os.Stdout).Encode(err.(*fragment.ValidationError)); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		} else {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+}
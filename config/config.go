@@ -0,0 +1,290 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/linter"
+	"github.com/vold-lu/validate-a-changelog/validator"
+	"github.com/vold-lu/validate-a-changelog/writer"
+	"gopkg.in/yaml.v3"
+)
+
+// Filenames contains the names of the configuration file, by order of precedence
+var Filenames = []string{".changelog.yaml", ".changelog.yml"}
+
+// Config is the project configuration shared by the commands (see Filenames)
+type Config struct {
+	// Path is the location of the loaded configuration file (empty if none)
+	Path string
+
+	// ChangeTypes contains the allowed change types, in order (Keep a Changelog ones when empty)
+	ChangeTypes []string
+	// BumpRules maps change types to the bump they require (overriding the default ones)
+	BumpRules map[string]validateachangelog.Bump
+	// TagPattern is the naming of the version tags (validateachangelog.DefaultTagPattern when empty)
+	TagPattern string
+	// Links contains the URL templates of the version links
+	Links validateachangelog.LinkTemplates
+
+	Validator ValidatorConfig
+	Linter    LinterConfig
+}
+
+type ValidatorConfig struct {
+	AllowEmptyVersion           bool `yaml:"allow-empty-version"`
+	AllowMissingReleaseDate     bool `yaml:"allow-missing-release-date"`
+	AllowInvalidChangeType      bool `yaml:"allow-invalid-change-type"`
+	AllowInvalidChangeTypeOrder bool `yaml:"allow-invalid-change-type-order"`
+	CheckMissingLink            bool `yaml:"check-missing-link"`
+	CheckOrphanLink             bool `yaml:"check-orphan-link"`
+	CheckCompareLink            bool `yaml:"check-compare-link"`
+	CheckSemVerBump             bool `yaml:"check-semver-bump"`
+	// Repository is the git repository whose tags are cross-checked (relative to the configuration file)
+	Repository string `yaml:"repository"`
+	// Manifest is the project manifest whose version is cross-checked (relative to the configuration file)
+	Manifest string `yaml:"manifest"`
+	// Rules maps rule IDs to their severity (see validator.Options)
+	Rules map[string]validator.Severity `yaml:"-"`
+	// Baseline is the file of the accepted issues (relative to the configuration file)
+	Baseline string `yaml:"baseline"`
+}
+
+type LinterConfig struct {
+	// Aliases maps section names to the change type they are renamed to (added to the default ones)
+	Aliases map[string]string `yaml:"aliases"`
+	// DateFormats contains the release date formats to recover (e.g. DD/MM/YYYY or Go layouts)
+	DateFormats []string `yaml:"date-formats"`
+}
+
+// document is the layout of the configuration file, the bump rules and the rule severities being converted by Parse
+type document struct {
+	ChangeTypes []string          `yaml:"change-types"`
+	BumpRules   map[string]string `yaml:"bump-rules"`
+	TagPattern  string            `yaml:"tag-pattern"`
+	Links       struct {
+		Compare string `yaml:"compare"`
+		Initial string `yaml:"initial"`
+	} `yaml:"links"`
+	Validator struct {
+		ValidatorConfig `yaml:",inline"`
+		Rules           map[string]string `yaml:"rules"`
+	} `yaml:"validator"`
+	Linter LinterConfig `yaml:"linter"`
+}
+
+// Find looks for the configuration file in the directory and its parents (empty if there is none)
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		for _, filename := range Filenames {
+			path := filepath.Join(dir, filename)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return path, nil
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Discover loads the configuration file found from the directory (see Find), an empty configuration is returned if
+// there is none
+func Discover(dir string) (*Config, error) {
+	path, err := Find(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" {
+		return &Config{}, nil
+	}
+
+	return Load(path)
+}
+
+// Resolve loads the configuration file if given, or discovers the one of the changelog file directory
+func Resolve(filename, changelogFile string) (*Config, error) {
+	if filename != "" {
+		return Load(filename)
+	}
+
+	return Discover(filepath.Dir(changelogFile))
+}
+
+// Load loads the configuration file
+func Load(filename string) (*Config, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	cfg.Path = filename
+
+	// Resolve the paths relative to the configuration file
+	dir := filepath.Dir(filename)
+	if cfg.Validator.Repository != "" && !filepath.IsAbs(cfg.Validator.Repository) {
+		cfg.Validator.Repository = filepath.Join(dir, cfg.Validator.Repository)
+	}
+	if cfg.Validator.Manifest != "" && !filepath.IsAbs(cfg.Validator.Manifest) {
+		cfg.Validator.Manifest = filepath.Join(dir, cfg.Validator.Manifest)
+	}
+	if cfg.Validator.Baseline != "" && !filepath.IsAbs(cfg.Validator.Baseline) {
+		cfg.Validator.Baseline = filepath.Join(dir, cfg.Validator.Baseline)
+	}
+
+	return cfg, nil
+}
+
+// Parse parses the configuration content (YAML), unknown keys being rejected
+func Parse(b []byte) (*Config, error) {
+	var doc document
+
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	cfg := &Config{
+		ChangeTypes: doc.ChangeTypes,
+		TagPattern:  doc.TagPattern,
+		Links:       validateachangelog.LinkTemplates{Compare: doc.Links.Compare, Initial: doc.Links.Initial},
+		Validator:   doc.Validator.ValidatorConfig,
+		Linter:      doc.Linter,
+	}
+
+	var errs []string
+
+	for changeType, bump := range doc.BumpRules {
+		b, err := validateachangelog.ParseBump(bump)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("bump-rules.%s: %s", changeType, err))
+			continue
+		}
+
+		if cfg.BumpRules == nil {
+			cfg.BumpRules = map[string]validateachangelog.Bump{}
+		}
+		cfg.BumpRules[changeType] = b
+	}
+
+	for name, severity := range doc.Validator.Rules {
+		id, level, err := validator.ParseRuleSeverity(name + "=" + severity)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("validator.rules.%s: %s", name, err))
+			continue
+		}
+
+		if cfg.Validator.Rules == nil {
+			cfg.Validator.Rules = map[string]validator.Severity{}
+		}
+		cfg.Validator.Rules[id] = level
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("invalid configuration: %s", strings.Join(errs, ", "))
+	}
+
+	return cfg, nil
+}
+
+// GetChangeTypes returns the allowed change types (nil meaning the default ones)
+func (c *Config) GetChangeTypes() validateachangelog.ChangeTypes {
+	if len(c.ChangeTypes) == 0 {
+		return nil
+	}
+
+	return validateachangelog.NewChangeTypes(c.ChangeTypes...)
+}
+
+// GetBumpRules returns the default bump rules overridden by the configured ones
+func (c *Config) GetBumpRules() validateachangelog.BumpRules {
+	rules := validateachangelog.DefaultBumpRules()
+	for changeType, bump := range c.BumpRules {
+		rules[changeType] = bump
+	}
+
+	return rules
+}
+
+// ValidatorOptions returns the validator options
+func (c *Config) ValidatorOptions() *validator.Options {
+	var rules map[string]validator.Severity
+	if len(c.Validator.Rules) > 0 {
+		rules = make(map[string]validator.Severity, len(c.Validator.Rules))
+		for id, severity := range c.Validator.Rules {
+			rules[id] = severity
+		}
+	}
+
+	return &validator.Options{
+		AllowEmptyVersion:           c.Validator.AllowEmptyVersion,
+		AllowMissingReleaseDate:     c.Validator.AllowMissingReleaseDate,
+		AllowInvalidChangeType:      c.Validator.AllowInvalidChangeType,
+		AllowInvalidChangeTypeOrder: c.Validator.AllowInvalidChangeTypeOrder,
+		ChangeTypes:                 c.GetChangeTypes(),
+		CheckMissingLink:            c.Validator.CheckMissingLink,
+		CheckOrphanLink:             c.Validator.CheckOrphanLink,
+		CheckCompareLink:            c.Validator.CheckCompareLink,
+		CheckSemVerBump:             c.Validator.CheckSemVerBump,
+		BumpRules:                   c.GetBumpRules(),
+		Repository:                  c.Validator.Repository,
+		TagPattern:                  c.TagPattern,
+		Manifest:                    c.Validator.Manifest,
+		Rules:                       rules,
+	}
+}
+
+// LinterOptions returns the linter options
+func (c *Config) LinterOptions() *linter.Options {
+	aliases := linter.DefaultAliases()
+	for section, changeType := range c.Linter.Aliases {
+		aliases[section] = changeType
+	}
+
+	var dateFormats []string
+	for _, format := range c.Linter.DateFormats {
+		dateFormats = append(dateFormats, DateLayout(format))
+	}
+
+	return &linter.Options{
+		ChangeTypes: c.GetChangeTypes(),
+		Aliases:     aliases,
+		DateFormats: dateFormats,
+	}
+}
+
+// WriterOptions returns the writer options
+func (c *Config) WriterOptions() *writer.Options {
+	return &writer.Options{
+		ChangeTypes: c.GetChangeTypes(),
+	}
+}
+
+// DateLayout converts the date format (e.g. DD/MM/YYYY) to a Go layout (formats without YYYY, MM or DD tokens are
+// considered as Go layouts)
+func DateLayout(format string) string {
+	return strings.NewReplacer("YYYY", "2006", "MM", "01", "DD", "02").Replace(format)
+}
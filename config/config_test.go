@@ -0,0 +1,134 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/validator"
+)
+
+const sample = `# Project changelog configuration
+change-types: [Breaking, Added, Changed, Performance, Fixed, Security]
+bump-rules:
+  Performance: minor
+tag-pattern: release-{version}
+links:
+  compare: https://example.org/compare/{previous}...{current}
+  initial: https://example.org/releases/tag/{current}
+validator:
+  allow-missing-release-date: true
+  check-missing-link: true
+  check-semver-bump: true
+  manifest: package.json
+  baseline: .changelog-baseline.json
+  rules:
+    unsorted-change-type: warning
+    CL006: info
+linter:
+  aliases:
+    Perf: Performance
+  date-formats:
+    - DD/MM/YYYY
+    - January 2, 2006
+`
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse([]byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(cfg.ChangeTypes, ",") != "Breaking,Added,Changed,Performance,Fixed,Security" {
+		t.Fatalf("Unexpected change types: %v", cfg.ChangeTypes)
+	}
+
+	if cfg.TagPattern != "release-{version}" || cfg.Links.Compare != "https://example.org/compare/{previous}...{current}" {
+		t.Fatalf("Unexpected configuration: %+v", cfg)
+	}
+
+	opts := cfg.ValidatorOptions()
+	if !opts.AllowMissingReleaseDate || opts.AllowEmptyVersion || !opts.CheckMissingLink || !opts.CheckSemVerBump {
+		t.Fatalf("Unexpected validator options: %+v", opts)
+	}
+
+	if opts.BumpRules.Get("Performance") != validateachangelog.BumpMinor || opts.BumpRules.Get("Removed") != validateachangelog.BumpMajor {
+		t.Fatalf("Unexpected bump rules: %v", opts.BumpRules)
+	}
+
+	if opts.ChangeTypes.Weight("Breaking") != 0 || opts.TagPattern != "release-{version}" {
+		t.Fatalf("Unexpected validator options: %+v", opts)
+	}
+
+	if !reflect.DeepEqual(opts.Rules, map[string]validator.Severity{"CL004": validator.SeverityWarning, "CL006": validator.SeverityInfo}) {
+		t.Fatalf("Unexpected rules: %v", opts.Rules)
+	}
+
+	linterOpts := cfg.LinterOptions()
+	if linterOpts.Aliases["Perf"] != "Performance" || linterOpts.Aliases["fix"] != "Fixed" {
+		t.Fatalf("Unexpected aliases: %v", linterOpts.Aliases)
+	}
+
+	if !reflect.DeepEqual(linterOpts.DateFormats, []string{"02/01/2006", "January 2, 2006"}) {
+		t.Fatalf("Unexpected date formats: %v", linterOpts.DateFormats)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []string{
+		"validator:\n  allow-empty-versions: true\n",
+		"validator:\n  allow-empty-version: maybe\n",
+		"change-types: Added\n",
+		"bump-rules:\n  Added: huge\n",
+		"validator:\n  rules:\n    CL999: warning\n",
+		"validator:\n  rules:\n    CL004: fatal\n",
+		"- not a mapping\n",
+	}
+
+	for _, c := range cases {
+		if _, err := Parse([]byte(c)); err == nil {
+			t.Logf("Parse(%q) should have failed", c)
+			t.Fail()
+		}
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "docs", "changelog")
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	// No configuration file
+	cfg, err := Discover(dir)
+	if err != nil || cfg.Path != "" {
+		t.Fatalf("Expected empty configuration. Got (%+v, %v)", cfg, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, ".changelog.yaml"), []byte(sample), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err = Discover(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Path != filepath.Join(root, ".changelog.yaml") {
+		t.Fatalf("Unexpected configuration path: %s", cfg.Path)
+	}
+
+	// Paths are relative to the configuration file
+	if cfg.Validator.Manifest != filepath.Join(root, "package.json") {
+		t.Fatalf("Unexpected manifest path: %s", cfg.Validator.Manifest)
+	}
+
+	if cfg.Validator.Baseline != filepath.Join(root, ".changelog-baseline.json") {
+		t.Fatalf("Unexpected baseline path: %s", cfg.Validator.Baseline)
+	}
+}
@@ -0,0 +1,110 @@
+package validateachangelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+// AddEntry appends an entry to the section of the version. The section is created (following the standard change
+// types order) if missing, so is the Unreleased version. Change type matching standard ones is case-insensitive.
+func (c *Changelog) AddEntry(version, changeType, description string) error {
+	return c.AddEntryWithChangeTypes(version, changeType, description, nil)
+}
+
+// AddEntryWithChangeTypes is AddEntry using the given change types (DefaultChangeTypes when nil) to normalize the
+// change type and order the sections
+func (c *Changelog) AddEntryWithChangeTypes(version, changeType, description string, changeTypes ChangeTypes) error {
+	if changeTypes == nil {
+		changeTypes = DefaultChangeTypes()
+	}
+
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return fmt.Errorf("empty entry description")
+	}
+
+	changeType = changeTypes.Normalize(strings.TrimSpace(changeType))
+	if changeType == "" {
+		return fmt.Errorf("empty change type")
+	}
+
+	var v *Version
+	for _, current := range c.Versions {
+		if current.Version == version {
+			v = current
+			break
+		}
+	}
+
+	if v == nil {
+		if version != UnreleasedVersion {
+			return fmt.Errorf("version %s not found in changelog", version)
+		}
+
+		// Create the Unreleased version on top of the others
+		v = &Version{
+			Version:          UnreleasedVersion,
+			Entries:          *internal.NewEmptyMap[string, []Entry](),
+			SectionPositions: map[string]Position{},
+		}
+		c.Versions = append([]*Version{v}, c.Versions...)
+		v.URL, _ = c.GetLink(UnreleasedVersion)
+	}
+
+	if entries, exists := v.Entries.Get(changeType); exists {
+		return v.Entries.Set(changeType, append(entries, Entry{Description: description}))
+	}
+
+	// Register the section before the first one with a greater weight (unknown sections being last)
+	weight := changeTypes.Weight(changeType)
+
+	index := v.Entries.Len()
+	for i, key := range v.Entries.Keys() {
+		if changeTypes.Weight(key) > weight {
+			index = i
+			break
+		}
+	}
+
+	return v.Entries.Insert(index, changeType, []Entry{{Description: description}})
+}
+
+// AppendEntry appends the entry at the given depth (0 being the top level), as a child of the last entries
+func AppendEntry(entries []Entry, depth int, entry Entry) []Entry {
+	if depth <= 0 || len(entries) == 0 {
+		return append(entries, entry)
+	}
+
+	lastEntry := &entries[len(entries)-1]
+	lastEntry.Children = AppendEntry(lastEntry.Children, depth-1, entry)
+
+	return entries
+}
+
+// LastEntry returns the deepest last entry (nil if there is none)
+func LastEntry(entries []Entry) *Entry {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	lastEntry := &entries[len(entries)-1]
+	if child := LastEntry(lastEntry.Children); child != nil {
+		return child
+	}
+
+	return lastEntry
+}
+
+// WalkEntries calls fn for each entry and its children (depth first), depth being 0 for top level entries
+func WalkEntries(entries []Entry, fn func(entry *Entry, depth int)) {
+	walkEntries(entries, 0, fn)
+}
+
+func walkEntries(entries []Entry, depth int, fn func(entry *Entry, depth int)) {
+	for i := range entries {
+		fn(&entries[i], depth)
+		walkEntries(entries[i].Children, depth+1, fn)
+	}
+}
@@ -0,0 +1,169 @@
+package validateachangelog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAppendEntry(t *testing.T) {
+	var entries []Entry
+
+	entries = AppendEntry(entries, 0, Entry{Description: "a"})
+	entries = AppendEntry(entries, 1, Entry{Description: "a.1"})
+	entries = AppendEntry(entries, 2, Entry{Description: "a.1.1"})
+	entries = AppendEntry(entries, 1, Entry{Description: "a.2"})
+	entries = AppendEntry(entries, 0, Entry{Description: "b"})
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 top level entries. Got: %d", len(entries))
+	}
+
+	if len(entries[0].Children) != 2 {
+		t.Fatalf("Expected 2 children. Got: %d", len(entries[0].Children))
+	}
+
+	if len(entries[0].Children[0].Children) != 1 || entries[0].Children[0].Children[0].Description != "a.1.1" {
+		t.Fatalf("Unexpected grand children: %v", entries[0].Children[0].Children)
+	}
+
+	if len(entries[1].Children) != 0 {
+		t.Fatalf("Expected no children. Got: %d", len(entries[1].Children))
+	}
+}
+
+func TestAppendEntryDepthWithoutParent(t *testing.T) {
+	entries := AppendEntry(nil, 2, Entry{Description: "a"})
+
+	if len(entries) != 1 || entries[0].Description != "a" {
+		t.Fatalf("Expected entry to be appended at top level. Got: %v", entries)
+	}
+}
+
+func TestLastEntry(t *testing.T) {
+	if LastEntry(nil) != nil {
+		t.Fatal("Expected no last entry")
+	}
+
+	entries := []Entry{
+		{Description: "a"},
+		{Description: "b", Children: []Entry{{Description: "b.1"}}},
+	}
+
+	lastEntry := LastEntry(entries)
+	if lastEntry == nil || lastEntry.Description != "b.1" {
+		t.Fatalf("Expected b.1 as last entry. Got: %v", lastEntry)
+	}
+
+	lastEntry.Description = "updated"
+	if entries[1].Children[0].Description != "updated" {
+		t.Fatal("Expected last entry to be updated in place")
+	}
+}
+
+func TestWalkEntries(t *testing.T) {
+	entries := []Entry{
+		{Description: "a", Children: []Entry{{Description: "a.1"}}},
+		{Description: "b"},
+	}
+
+	var descriptions []string
+	var depths []int
+
+	WalkEntries(entries, func(entry *Entry, depth int) {
+		descriptions = append(descriptions, entry.Description)
+		depths = append(depths, depth)
+	})
+
+	if len(descriptions) != 3 || descriptions[0] != "a" || descriptions[1] != "a.1" || descriptions[2] != "b" {
+		t.Fatalf("Unexpected walk order: %v", descriptions)
+	}
+
+	if depths[0] != 0 || depths[1] != 1 || depths[2] != 0 {
+		t.Fatalf("Unexpected walk depths: %v", depths)
+	}
+}
+
+func TestChangelogAddEntry(t *testing.T) {
+	c := newReleasableChangelog()
+
+	entries := []struct {
+		ChangeType  string
+		Description string
+	}{
+		{ChangeType: "fixed", Description: "Bug."},
+		{ChangeType: "Security", Description: "Vulnerability."},
+		{ChangeType: "Changed", Description: "Behaviour."},
+		{ChangeType: "Performance", Description: "Faster."},
+		{ChangeType: "Fixed", Description: "Another bug."},
+	}
+
+	for _, entry := range entries {
+		if err := c.AddEntry("Unreleased", entry.ChangeType, entry.Description); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys := c.Versions[0].Entries.Keys()
+	wantedKeys := []string{"Added", "Changed", "Fixed", "Security", "Performance"}
+
+	if strings.Join(keys, ",") != strings.Join(wantedKeys, ",") {
+		t.Fatalf("Unexpected sections. Got: %v, wanted: %v", keys, wantedKeys)
+	}
+
+	fixed, _ := c.Versions[0].Entries.Get("Fixed")
+	if len(fixed) != 2 || fixed[1].Description != "Another bug." {
+		t.Fatalf("Unexpected Fixed entries: %v", fixed)
+	}
+}
+
+func TestChangelogAddEntryCreatesUnreleased(t *testing.T) {
+	c := newReleasableChangelog()
+	c.Versions = c.Versions[1:]
+
+	if err := c.AddEntry("Unreleased", "Added", "Feature."); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Versions) != 2 || c.Versions[0].Version != "Unreleased" {
+		t.Fatalf("Expected Unreleased version to be created on top. Got: %v", c.Versions[0])
+	}
+
+	if c.Versions[0].URL != "https://example.org/compare/v1.0.0...HEAD" {
+		t.Fatalf("Unexpected Unreleased URL: %s", c.Versions[0].URL)
+	}
+}
+
+func TestChangelogAddEntryErrors(t *testing.T) {
+	c := newReleasableChangelog()
+
+	if err := c.AddEntry("2.0.0", "Added", "Feature."); err == nil {
+		t.Fatal("AddEntry() should fail on unknown version")
+	}
+
+	if err := c.AddEntry("Unreleased", "Added", "  "); err == nil {
+		t.Fatal("AddEntry() should fail on empty description")
+	}
+
+	if err := c.AddEntry("Unreleased", "", "Feature."); err == nil {
+		t.Fatal("AddEntry() should fail on empty change type")
+	}
+}
+
+func TestChangelogAddEntryWithChangeTypes(t *testing.T) {
+	c := newReleasableChangelog()
+
+	changeTypes := NewChangeTypes("Breaking", "Added", "Performance", "Fixed")
+
+	for _, changeType := range []string{"performance", "Breaking", "Fixed"} {
+		if err := c.AddEntryWithChangeTypes("Unreleased", changeType, "Something.", changeTypes); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys := c.Versions[0].Entries.Keys()
+	wantedKeys := []string{"Breaking", "Added", "Performance", "Fixed"}
+
+	if strings.Join(keys, ",") != strings.Join(wantedKeys, ",") {
+		t.Fatalf("Unexpected sections. Got: %v, wanted: %v", keys, wantedKeys)
+	}
+}
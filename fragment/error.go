@@ -0,0 +1,46 @@
+package fragment
+
+import "strings"
+
+type ValidationError struct {
+	Issues []ValidationIssue `json:"issues"`
+}
+
+func (v *ValidationError) Error() string {
+	var sb strings.Builder
+
+	for _, issue := range v.Issues {
+		sb.WriteString(issue.String() + "\n")
+	}
+
+	return sb.String()
+}
+
+func (v *ValidationError) pushIssue(fragment *Fragment, error string) {
+	v.Issues = append(v.Issues, ValidationIssue{
+		Name:  fragment.Name,
+		Path:  fragment.Path,
+		Error: error,
+	})
+}
+
+func (v *ValidationError) hasIssues() bool {
+	return len(v.Issues) > 0
+}
+
+type ValidationIssue struct {
+	// Name contains the fragment name
+	Name string `json:"name"`
+	// Path contains the fragment file location (when known)
+	Path string `json:"path"`
+	// Error is the human formatted error message
+	Error string `json:"error"`
+}
+
+func (vi *ValidationIssue) String() string {
+	if vi.Path != "" {
+		return "[fragment: " + vi.Path + "]: " + vi.Error
+	}
+
+	return "[fragment: " + vi.Name + "]: " + vi.Error
+}
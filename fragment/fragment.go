@@ -0,0 +1,223 @@
+package fragment
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+// DefaultDirectory is the directory where fragments are stored by default
+const DefaultDirectory = "changes"
+
+// Fragment is a changelog entry stored in its own file, either named after its change type (`changes/1234.fixed.md`)
+// or declaring it in YAML (`changes/1234.yaml`) or Markdown front matter
+type Fragment struct {
+	// Name is the fragment identifier (e.g. the pull request number)
+	Name        string `json:"name"`
+	ChangeType  string `json:"change_type"`
+	Description string `json:"description"`
+	// Path is the location of the fragment file
+	Path string `json:"path"`
+}
+
+// Load loads the fragments of the directory (sorted by file name). Hidden files and READMEs are ignored.
+func Load(dir string) ([]*Fragment, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var fragments []*Fragment
+
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || strings.HasPrefix(strings.ToUpper(file.Name()), "README") {
+			continue
+		}
+
+		fragment, err := LoadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		fragments = append(fragments, fragment)
+	}
+
+	return fragments, nil
+}
+
+// LoadFile loads a single fragment file
+func LoadFile(filename string) (*Fragment, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	fragment, err := Parse(filepath.Base(filename), b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	fragment.Path = filename
+
+	return fragment, nil
+}
+
+// Parse parses the fragment content, using the file name to determinate its format
+func Parse(filename string, b []byte) (*Fragment, error) {
+	ext := filepath.Ext(filename)
+	name := strings.TrimSuffix(filename, ext)
+
+	switch strings.ToLower(ext) {
+	case ".yaml", ".yml":
+		return parseYAMLFragment(name, b)
+	case ".md":
+		content := strings.ReplaceAll(string(b), "\r\n", "\n")
+
+		// Front matter (`---` delimited YAML header)
+		if strings.HasPrefix(content, "---\n") {
+			frontMatter, body, found := strings.Cut(content[4:], "\n---")
+			if !found {
+				return nil, fmt.Errorf("unterminated front matter")
+			}
+
+			fragment, err := parseYAMLFragment(name, []byte(frontMatter))
+			if err != nil {
+				return nil, err
+			}
+
+			if fragment.Description == "" {
+				_, body, _ = strings.Cut(body, "\n")
+				fragment.Description = parseDescription(body)
+			}
+
+			return fragment, nil
+		}
+
+		// Change type in the file name (`1234.fixed.md`)
+		dot := strings.LastIndex(name, ".")
+		if dot == -1 {
+			return nil, fmt.Errorf("missing change type in fragment name (expected <name>.<change type>.md)")
+		}
+
+		return &Fragment{
+			Name:        name[:dot],
+			ChangeType:  name[dot+1:],
+			Description: parseDescription(content),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported fragment format: %s (available formats: .md, .yaml, .yml)", ext)
+	}
+}
+
+func parseYAMLFragment(name string, b []byte) (*Fragment, error) {
+	value, err := internal.ParseYAML(b)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("fragment must be a mapping with type and description")
+	}
+
+	fragment := &Fragment{Name: name}
+
+	if changeType, ok := m["type"].(string); ok {
+		fragment.ChangeType = changeType
+	}
+
+	if description, ok := m["description"].(string); ok {
+		fragment.Description = parseDescription(description)
+	}
+
+	return fragment, nil
+}
+
+// parseDescription joins the description lines (a leading bullet being optional)
+func parseDescription(text string) string {
+	description := ""
+
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if description == "" && internal.IsEntryLine(line) {
+			line = internal.ParseEntryLine(line)
+		}
+
+		description = internal.JoinContinuationLine(description, line)
+	}
+
+	return description
+}
+
+type Options struct {
+	// ChangeTypes contains the allowed change types (validateachangelog.DefaultChangeTypes when nil)
+	ChangeTypes validateachangelog.ChangeTypes
+}
+
+// Validate makes sure the fragments have a known change type and a description
+func Validate(fragments []*Fragment, opts *Options) error {
+	changeTypes := changeTypesOf(opts)
+
+	err := &ValidationError{}
+
+	for _, fragment := range fragments {
+		if fragment.ChangeType == "" {
+			err.pushIssue(fragment, "missing change type")
+		} else if !changeTypes.Has(changeTypes.Normalize(fragment.ChangeType)) {
+			err.pushIssue(fragment, fmt.Sprintf("invalid change type `%s` (available values: %v)", fragment.ChangeType, changeTypes.Names()))
+		}
+
+		if fragment.Description == "" {
+			err.pushIssue(fragment, "empty description")
+		}
+	}
+
+	if err.hasIssues() {
+		return err
+	}
+
+	return nil
+}
+
+// Assemble validates the fragments and adds them to the version of the changelog (Unreleased being created if missing)
+func Assemble(c *validateachangelog.Changelog, fragments []*Fragment, version string, opts *Options) error {
+	if err := Validate(fragments, opts); err != nil {
+		return err
+	}
+
+	changeTypes := changeTypesOf(opts)
+
+	for _, fragment := range fragments {
+		if err := c.AddEntryWithChangeTypes(version, fragment.ChangeType, fragment.Description, changeTypes); err != nil {
+			return fmt.Errorf("%s: %w", fragment.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// Remove deletes the fragment files (e.g. once assembled)
+func Remove(fragments []*Fragment) error {
+	for _, fragment := range fragments {
+		if err := os.Remove(fragment.Path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func changeTypesOf(opts *Options) validateachangelog.ChangeTypes {
+	if opts == nil || opts.ChangeTypes == nil {
+		return validateachangelog.DefaultChangeTypes()
+	}
+
+	return opts.ChangeTypes
+}
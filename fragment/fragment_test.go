@@ -0,0 +1,144 @@
+package fragment
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		Filename string
+		Value    string
+		Want     Fragment
+	}{
+		{
+			Filename: "1234.fixed.md",
+			Value:    "- Fix the crash when the changelog\n  is empty.\n",
+			Want:     Fragment{Name: "1234", ChangeType: "fixed", Description: "Fix the crash when the changelog is empty."},
+		},
+		{
+			Filename: "feature.v2.added.md",
+			Value:    "Add a new command.",
+			Want:     Fragment{Name: "feature.v2", ChangeType: "added", Description: "Add a new command."},
+		},
+		{
+			Filename: "1235.md",
+			Value:    "---\ntype: Security\n---\n\nUpgrade dependencies.\n",
+			Want:     Fragment{Name: "1235", ChangeType: "Security", Description: "Upgrade dependencies."},
+		},
+		{
+			Filename: "1236.yaml",
+			Value:    "type: removed\ndescription: >\n  Remove the deprecated\n  flag.\n",
+			Want:     Fragment{Name: "1236", ChangeType: "removed", Description: "Remove the deprecated flag."},
+		},
+	}
+
+	for _, c := range cases {
+		fragment, err := Parse(c.Filename, []byte(c.Value))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *fragment != c.Want {
+			t.Logf("Parse(%s). Got: %+v, wanted: %+v", c.Filename, *fragment, c.Want)
+			t.Fail()
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"1234.md":       "Missing change type.",
+		"1234.txt":      "Unsupported format.",
+		"1234.yaml":     "- not a mapping",
+		"1235.fixed.md": "---\ntype: fixed\n",
+	}
+
+	for filename, value := range cases {
+		if _, err := Parse(filename, []byte(value)); err == nil {
+			t.Logf("Parse(%s) should have failed", filename)
+			t.Fail()
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	fragments := []*Fragment{
+		{Name: "1", ChangeType: "fixed", Description: "Bug."},
+		{Name: "2", ChangeType: "Improved", Description: "Something."},
+		{Name: "3", ChangeType: "", Description: ""},
+	}
+
+	err := Validate(fragments, nil)
+	if err == nil {
+		t.Fatal("Validate() should have failed")
+	}
+
+	if issues := err.(*ValidationError).Issues; len(issues) != 3 {
+		t.Fatalf("Expected 3 issues. Got: %v", issues)
+	}
+
+	if err := Validate(fragments[:1], nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Validate(fragments[:2], &Options{ChangeTypes: validateachangelog.NewChangeTypes("Fixed", "Improved")}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAssemble(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"1234.fixed.md": "Fix the crash.",
+		"1235.yaml":     "type: added\ndescription: Add a command.",
+		".gitkeep":      "",
+		"README.md":     "Drop your fragments here.",
+	}
+
+	for filename, value := range files {
+		if err := os.WriteFile(filepath.Join(dir, filename), []byte(value), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fragments, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fragments) != 2 {
+		t.Fatalf("Expected 2 fragments. Got: %d", len(fragments))
+	}
+
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "1.0.0",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+		},
+	}
+
+	if err := Assemble(c, fragments, validateachangelog.UnreleasedVersion, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	unreleased := c.Unreleased()
+	if unreleased == nil || unreleased.Entries.Len() != 2 || unreleased.Entries.Keys()[0] != "Added" {
+		t.Fatalf("Unexpected Unreleased version: %v", unreleased)
+	}
+
+	if err := Remove(fragments); err != nil {
+		t.Fatal(err)
+	}
+
+	if fragments, _ := Load(dir); len(fragments) != 0 {
+		t.Fatalf("Expected fragments to be removed. Got: %d", len(fragments))
+	}
+}
@@ -2,4 +2,7 @@ module github.com/vold-lu/validate-a-changelog
 
 go 1.25
 
-require golang.org/x/mod v0.29.0 // indirect
+require (
+	golang.org/x/mod v0.29.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,171 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+// BreakingPrefix is prepended to the description of the breaking changes (understood by Entry#IsBreaking)
+const BreakingPrefix = "**BREAKING**: "
+
+var (
+	conventionalCommitRegex = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^()]*)\))?(!)?: +(.+)$`)
+	breakingChangeRegex     = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE: `)
+)
+
+type Options struct {
+	// TagPattern is the naming of the version tags (validateachangelog.DefaultTagPattern when empty)
+	TagPattern string
+	// Types maps the Conventional Commit types to the changelog sections (DefaultTypes when nil)
+	Types map[string]string
+}
+
+// DefaultTypes returns the default mapping of the Conventional Commit types to the changelog sections
+func DefaultTypes() map[string]string {
+	return map[string]string{
+		"feat":   "Added",
+		"fix":    "Fixed",
+		"perf":   "Changed",
+		"revert": "Changed",
+	}
+}
+
+// ConventionalCommit is a commit message following the Conventional Commits specification
+// (`feat(parser)!: description`)
+type ConventionalCommit struct {
+	Type        string
+	Scope       string
+	Description string
+	Breaking    bool
+}
+
+// ParseConventionalCommit parses the commit message (false if it does not follow Conventional Commits)
+func ParseConventionalCommit(message string) (ConventionalCommit, bool) {
+	subject, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+
+	matches := conventionalCommitRegex.FindStringSubmatch(strings.TrimSpace(subject))
+	if len(matches) == 0 {
+		return ConventionalCommit{}, false
+	}
+
+	return ConventionalCommit{
+		Type:        strings.ToLower(matches[1]),
+		Scope:       matches[2],
+		Description: strings.TrimSpace(matches[4]),
+		Breaking:    matches[3] == "!" || breakingChangeRegex.MatchString(body),
+	}, true
+}
+
+// Entry returns the changelog entry description of the commit
+func (cc ConventionalCommit) Entry() string {
+	description := cc.Description
+
+	// Capitalize the description (feat: add x -> Add x)
+	if r, size := utf8.DecodeRuneInString(description); r != utf8.RuneError {
+		description = string(unicode.ToUpper(r)) + description[size:]
+	}
+
+	if cc.Scope != "" {
+		description = cc.Scope + ": " + description
+	}
+
+	if cc.Breaking {
+		description = BreakingPrefix + description
+	}
+
+	return description
+}
+
+// Import adds the Conventional Commits made since the latest version tag of the git repository to the Unreleased
+// version (created if missing). Commits already in the changelog are skipped. The number of imported entries is
+// returned.
+func Import(c *validateachangelog.Changelog, dir string, opts *Options) (int, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	types := opts.Types
+	if types == nil {
+		types = DefaultTypes()
+	}
+
+	messages, err := commitMessages(dir, opts.TagPattern)
+	if err != nil {
+		return 0, err
+	}
+
+	// Index the existing entries to skip them
+	existing := map[string]bool{}
+	for _, version := range c.Versions {
+		for _, changeType := range version.Entries.Keys() {
+			entries, _ := version.Entries.Get(changeType)
+
+			validateachangelog.WalkEntries(entries, func(entry *validateachangelog.Entry, _ int) {
+				existing[normalizeDescription(entry.Description)] = true
+			})
+		}
+	}
+
+	imported := 0
+
+	for _, message := range messages {
+		commit, ok := ParseConventionalCommit(message)
+		if !ok {
+			continue
+		}
+
+		section, exists := types[commit.Type]
+		if !exists {
+			continue
+		}
+
+		description := commit.Entry()
+		if existing[normalizeDescription(description)] {
+			continue
+		}
+
+		if err := c.AddEntry(validateachangelog.UnreleasedVersion, section, description); err != nil {
+			return imported, err
+		}
+
+		existing[normalizeDescription(description)] = true
+		imported++
+	}
+
+	return imported, nil
+}
+
+// commitMessages returns the messages (oldest first) of the non merge commits made since the latest version tag
+func commitMessages(dir, tagPattern string) ([]string, error) {
+	revision := "HEAD"
+
+	// Find the latest version tag reachable from HEAD (the whole history is used if there is none)
+	tag, err := internal.RunGit(dir, "describe", "--tags", "--abbrev=0", "--match", validateachangelog.TagName(tagPattern, "*"))
+	if err == nil && tag != "" {
+		revision = tag + "..HEAD"
+	}
+
+	out, err := internal.RunGit(dir, "log", "--reverse", "--no-merges", "--format=%B%x1e", revision)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read git log: %w", err)
+	}
+
+	var messages []string
+	for _, message := range strings.Split(out, "\x1e") {
+		if message = strings.TrimSpace(message); message != "" {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
+func normalizeDescription(description string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(description), "."))
+}
@@ -0,0 +1,136 @@
+package importer
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	cases := []struct {
+		Message string
+		Commit  ConventionalCommit
+		IsValid bool
+	}{
+		{Message: "feat: add import command", Commit: ConventionalCommit{Type: "feat", Description: "add import command"}, IsValid: true},
+		{Message: "fix(parser): handle empty lines", Commit: ConventionalCommit{Type: "fix", Scope: "parser", Description: "handle empty lines"}, IsValid: true},
+		{Message: "feat!: drop Go 1.22", Commit: ConventionalCommit{Type: "feat", Description: "drop Go 1.22", Breaking: true}, IsValid: true},
+		{Message: "refactor: rework\n\nBREAKING CHANGE: options are required", Commit: ConventionalCommit{Type: "refactor", Description: "rework", Breaking: true}, IsValid: true},
+		{Message: "Merge branch 'main'", IsValid: false},
+		{Message: "feat add command", IsValid: false},
+	}
+
+	for _, c := range cases {
+		commit, ok := ParseConventionalCommit(c.Message)
+		if ok != c.IsValid || commit != c.Commit {
+			t.Logf("ParseConventionalCommit(%q). Got (%+v, %v), wanted %+v", c.Message, commit, ok, c.Commit)
+			t.Fail()
+		}
+	}
+}
+
+func TestConventionalCommitEntry(t *testing.T) {
+	commit := ConventionalCommit{Type: "feat", Scope: "cli", Description: "add flag", Breaking: true}
+
+	if entry := commit.Entry(); entry != "**BREAKING**: cli: Add flag" {
+		t.Fatalf("Unexpected entry: %s", entry)
+	}
+
+	e := validateachangelog.Entry{Description: commit.Entry()}
+	if !e.IsBreaking() {
+		t.Fatal("Expected entry to be breaking")
+	}
+}
+
+func newRepository(t *testing.T) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	dir := t.TempDir()
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.name", "Test"},
+		{"config", "user.email", "test@example.org"},
+		{"config", "commit.gpgsign", "false"},
+		{"config", "tag.gpgsign", "false"},
+	} {
+		if _, err := internal.RunGit(dir, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func commit(t *testing.T, dir, message string) {
+	if _, err := internal.RunGit(dir, "commit", "-q", "--allow-empty", "-m", message); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImport(t *testing.T) {
+	dir := newRepository(t)
+
+	commit(t, dir, "feat: initial release")
+	if _, err := internal.RunGit(dir, "tag", "v1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	commit(t, dir, "fix: handle empty changelog")
+	commit(t, dir, "docs: update README")
+	commit(t, dir, "feat(cli): add import command")
+	commit(t, dir, "perf!: stream the changelog\n\nThe reader is now consumed once.")
+	commit(t, dir, "fix: already documented")
+
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "Unreleased",
+				Entries: *internal.NewSortedMap([]string{"Fixed"}, map[string][]validateachangelog.Entry{
+					"Fixed": {{Description: "Already documented."}},
+				}),
+			},
+			{
+				Version: "1.0.0",
+				Entries: *internal.NewSortedMap([]string{"Added"}, map[string][]validateachangelog.Entry{
+					"Added": {{Description: "Initial release."}},
+				}),
+			},
+		},
+	}
+
+	imported, err := Import(c, dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if imported != 3 {
+		t.Fatalf("Expected 3 imported entries. Got: %d", imported)
+	}
+
+	unreleased := c.Unreleased()
+
+	added, _ := unreleased.Entries.Get("Added")
+	if len(added) != 1 || added[0].Description != "cli: Add import command" {
+		t.Fatalf("Unexpected Added entries: %v", added)
+	}
+
+	changed, _ := unreleased.Entries.Get("Changed")
+	if len(changed) != 1 || !changed[0].IsBreaking() {
+		t.Fatalf("Unexpected Changed entries: %v", changed)
+	}
+
+	fixed, _ := unreleased.Entries.Get("Fixed")
+	if len(fixed) != 2 || fixed[1].Description != "Handle empty changelog" {
+		t.Fatalf("Unexpected Fixed entries: %v", fixed)
+	}
+
+	// Importing twice must not duplicate entries
+	if imported, err := Import(c, dir, nil); err != nil || imported != 0 {
+		t.Fatalf("Expected nothing to import. Got (%d, %v)", imported, err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"cmp"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"sync"
 )
 
@@ -43,6 +44,11 @@ func (sm *SortedMap[K, V]) Set(key K, val V) error {
 	sm.mutex.Lock()
 	defer sm.mutex.Unlock()
 
+	// Handle zero value map
+	if sm.values == nil {
+		sm.values = make(map[K]V)
+	}
+
 	if _, ok := sm.values[key]; !ok {
 		sm.keys = append(sm.keys, key)
 	}
@@ -52,6 +58,29 @@ func (sm *SortedMap[K, V]) Set(key K, val V) error {
 	return nil
 }
 
+// Insert sets the value and inserts the key at the given index (existing keys keep their position)
+func (sm *SortedMap[K, V]) Insert(index int, key K, val V) error {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	// Handle zero value map
+	if sm.values == nil {
+		sm.values = make(map[K]V)
+	}
+
+	if _, ok := sm.values[key]; !ok {
+		index = max(0, min(index, len(sm.keys)))
+
+		sm.keys = append(sm.keys, key)
+		copy(sm.keys[index+1:], sm.keys[index:])
+		sm.keys[index] = key
+	}
+
+	sm.values[key] = val
+
+	return nil
+}
+
 func (sm *SortedMap[K, V]) Del(key K) error {
 	sm.mutex.Lock()
 	defer sm.mutex.Unlock()
@@ -118,7 +147,11 @@ func (sm *SortedMap[K, V]) MarshalJSON() ([]byte, error) {
 		}
 
 		// Serialize the key
-		bb.WriteString(fmt.Sprintf("\"%v\"", key))
+		if b, err := json.Marshal(fmt.Sprint(key)); err != nil {
+			return nil, err
+		} else {
+			bb.Write(b)
+		}
 
 		bb.WriteRune(':')
 
@@ -134,3 +167,83 @@ func (sm *SortedMap[K, V]) MarshalJSON() ([]byte, error) {
 
 	return bb.Bytes(), nil
 }
+
+// UnmarshalJSON Re implement the JSON deserialization to ensure keys ordering is preserved
+func (sm *SortedMap[K, V]) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	// By convention, null is a no-op
+	if tok == nil {
+		return nil
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("SortedMap: expected JSON object, got: %v", tok)
+	}
+
+	values := make(map[K]V)
+	keys := make([]K, 0)
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, err := parseKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+
+		var val V
+		if err := dec.Decode(&val); err != nil {
+			return err
+		}
+
+		if _, exists := values[key]; !exists {
+			keys = append(keys, key)
+		}
+
+		values[key] = val
+	}
+
+	// Consume the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	sm.values = values
+	sm.keys = keys
+
+	return nil
+}
+
+// parseKey converts the JSON object key back to the map key type
+func parseKey[K cmp.Ordered](s string) (K, error) {
+	var key K
+
+	if p, ok := any(&key).(*string); ok {
+		*p = s
+		return key, nil
+	}
+
+	// String based keys
+	if err := json.Unmarshal([]byte(strconv.Quote(s)), &key); err == nil {
+		return key, nil
+	}
+
+	// Numeric keys
+	if err := json.Unmarshal([]byte(s), &key); err != nil {
+		return key, fmt.Errorf("SortedMap: unable to parse key: %s", s)
+	}
+
+	return key, nil
+}
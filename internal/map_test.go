@@ -1,6 +1,9 @@
 package internal
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestNewEmptyMap(t *testing.T) {
 	m := NewEmptyMap[string, string]()
@@ -129,3 +132,107 @@ func TestSortedMap_Has(t *testing.T) {
 		}
 	}
 }
+
+func TestSortedMap_SetZeroValue(t *testing.T) {
+	var m SortedMap[string, string]
+
+	if err := m.Set("test", "alois"); err != nil {
+		t.Fatal(err)
+	}
+
+	if val, _ := m.Get("test"); val != "alois" || m.Len() != 1 {
+		t.Fatal("wrong value")
+	}
+}
+
+func TestSortedMap_MarshalJSON(t *testing.T) {
+	m := NewSortedMap([]string{"c", "a", "b\""}, map[string]int{"a": 1, "b\"": 2, "c": 3})
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"c":3,"a":1,"b\"":2}` {
+		t.Fatalf("MarshalJSON() returned wrong value: %s", string(b))
+	}
+}
+
+func TestSortedMap_UnmarshalJSON(t *testing.T) {
+	var m SortedMap[string, []int]
+
+	if err := json.Unmarshal([]byte(`{"c": [3], "a": [1], "b\"": [2, 2]}`), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Len() != 3 {
+		t.Fatal("UnmarshalJSON() returned wrong length")
+	}
+
+	for i, key := range []string{"c", "a", "b\""} {
+		if m.Keys()[i] != key {
+			t.Fatalf("UnmarshalJSON() returned wrong key order: %v", m.Keys())
+		}
+	}
+
+	if val, _ := m.Get("b\""); len(val) != 2 {
+		t.Fatal("UnmarshalJSON() returned wrong value")
+	}
+
+	// Make sure the map is still usable
+	if err := m.Set("d", []int{4}); err != nil || m.Len() != 4 {
+		t.Fatal("Set() failed after UnmarshalJSON()")
+	}
+}
+
+func TestSortedMap_UnmarshalJSONNumericKeys(t *testing.T) {
+	m := NewEmptyMap[int, string]()
+
+	if err := json.Unmarshal([]byte(`{"10": "ten", "2": "two"}`), m); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Keys()[0] != 10 || m.Keys()[1] != 2 {
+		t.Fatalf("UnmarshalJSON() returned wrong keys: %v", m.Keys())
+	}
+
+	if err := json.Unmarshal([]byte(`{"ten": "ten"}`), m); err == nil {
+		t.Fatal("UnmarshalJSON() should fail on invalid key")
+	}
+}
+
+func TestSortedMap_UnmarshalJSONInvalid(t *testing.T) {
+	m := NewEmptyMap[string, string]()
+
+	if err := json.Unmarshal([]byte(`["a"]`), m); err == nil {
+		t.Fatal("UnmarshalJSON() should fail on JSON array")
+	}
+
+	if err := json.Unmarshal([]byte(`null`), m); err != nil || m.Len() != 0 {
+		t.Fatal("UnmarshalJSON() should ignore null")
+	}
+}
+
+func TestSortedMap_Insert(t *testing.T) {
+	m := NewSortedMap([]string{"a", "c"}, map[string]int{"a": 1, "c": 3})
+
+	if err := m.Insert(1, "b", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Insert(99, "d", 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Insert(0, "c", 30); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, key := range []string{"a", "b", "c", "d"} {
+		if m.Keys()[i] != key {
+			t.Fatalf("Insert() returned wrong key order: %v", m.Keys())
+		}
+	}
+
+	if val, _ := m.Get("c"); val != 30 {
+		t.Fatal("Insert() returned wrong value")
+	}
+}
@@ -2,15 +2,21 @@ package internal
 
 import (
 	"regexp"
+	"strings"
 	"time"
 )
 
 var (
 	titleRegex             = regexp.MustCompile(`^# (.*)$`)
-	versionRegex           = regexp.MustCompile(`^## \[([0-9.]+)\] ?-? ?([0-9]{4}-[0-9]{2}-[0-9]{2})?$`)
-	unreleasedVersionRegex = regexp.MustCompile(`^## \[Unreleased\]$`)
+	versionRegex           = regexp.MustCompile(`^## \[([0-9.]+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?)\] ?-? ?([0-9]{4}-[0-9]{2}-[0-9]{2})?(?: ?\[YANKED\])?$`)
+	unreleasedVersionRegex = regexp.MustCompile(`^## \[Unreleased\](?: ?\[YANKED\])?$`)
 	sectionRegex           = regexp.MustCompile(`^### (.*)$`)
 	entryRegex             = regexp.MustCompile(`^[ \t]*- (.*)$`)
+	yankedVersionRegex     = regexp.MustCompile(` ?\[YANKED\]$`)
+	headingRegex           = regexp.MustCompile(`^#{1,6}( |$)`)
+	linkRegex              = regexp.MustCompile(`^ {0,3}\[([^\]]+)\]:[ \t]*(\S+)`)
+	directiveRegex         = regexp.MustCompile(`^\s*<!--\s*changelog-(disable-next-line|disable|enable)(?:\s+(.*?))?\s*-->\s*$`)
+	fenceRegex             = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
 )
 
 func IsTitleLine(line string) bool {
@@ -30,6 +36,11 @@ func IsVersionLine(line string) bool {
 	return versionRegex.MatchString(line) || unreleasedVersionRegex.MatchString(line)
 }
 
+// IsYankedVersionLine returns true if the version line is flagged as yanked (`## [0.0.5] - 2014-12-13 [YANKED]`)
+func IsYankedVersionLine(line string) bool {
+	return IsVersionLine(line) && yankedVersionRegex.MatchString(line)
+}
+
 func ParseVersionLine(line string) (string, *time.Time, error) {
 	// Handle unreleased
 	if unreleasedVersionRegex.MatchString(line) {
@@ -85,6 +96,100 @@ func ParseEntryLine(line string) string {
 	return matches[1]
 }
 
+func IsLinkLine(line string) bool {
+	return linkRegex.MatchString(line)
+}
+
+// ParseLinkLine returns the label and the URL of a link reference definition
+func ParseLinkLine(line string) (string, string) {
+	matches := linkRegex.FindStringSubmatch(line)
+	if len(matches) == 0 {
+		return "", ""
+	}
+
+	return matches[1], strings.Trim(matches[2], "<>")
+}
+
+// IsDirectiveLine returns true if the line is a validation directive comment (`<!-- changelog-disable ... -->`)
+func IsDirectiveLine(line string) bool {
+	return directiveRegex.MatchString(line)
+}
+
+// ParseDirectiveLine returns the action (disable, enable or disable-next-line) and the rules (comma or space
+// separated, all rules when empty) of a directive comment
+func ParseDirectiveLine(line string) (string, []string) {
+	matches := directiveRegex.FindStringSubmatch(line)
+	if len(matches) == 0 {
+		return "", nil
+	}
+
+	rules := strings.FieldsFunc(matches[2], func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+
+	return matches[1], rules
+}
+
+// ParseFenceLine returns the fence (e.g. ```) opening or closing a fenced code block (empty if the line is not a fence)
+func ParseFenceLine(line string) string {
+	matches := fenceRegex.FindStringSubmatch(line)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	return matches[1]
+}
+
+// IsTextLine returns true if the line is free-form text (neither a title, version, section, entry nor link line)
+func IsTextLine(line string) bool {
+	return !IsTitleLine(line) && !IsVersionLine(line) && !IsSectionLine(line) && !IsEntryLine(line) && !IsLinkLine(line)
+}
+
+// IsContinuationLine returns true if the line may continue a wrapped entry (lazy or indented continuation)
+func IsContinuationLine(line string) bool {
+	return strings.TrimSpace(line) != "" && !headingRegex.MatchString(line) && !entryRegex.MatchString(line) && !linkRegex.MatchString(line) &&
+		!directiveRegex.MatchString(line)
+}
+
+// JoinContinuationLine appends the continuation line to the entry description
+func JoinContinuationLine(description, line string) string {
+	description = strings.TrimRight(description, " \t")
+	line = strings.TrimSpace(line)
+
+	if description == "" {
+		return line
+	}
+
+	return description + " " + line
+}
+
+// AppendTextLine appends the line to the raw Markdown text, leading and consecutive blank lines are collapsed
+func AppendTextLine(text, line string) string {
+	if strings.TrimSpace(line) == "" {
+		if text == "" || strings.HasSuffix(text, "\n") {
+			return text
+		}
+
+		return text + "\n"
+	}
+
+	if text == "" {
+		return line
+	}
+
+	return text + "\n" + line
+}
+
+// TrimText removes the trailing blank lines of the raw Markdown text
+func TrimText(text string) string {
+	return strings.TrimRight(text, "\n")
+}
+
+// GetLineIndentation returns the number of leading whitespace characters of the line
+func GetLineIndentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
 func GetStandardChangeTypes() map[string]int {
 	return map[string]int{
 		"Added":      0,
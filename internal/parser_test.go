@@ -105,6 +105,22 @@ func TestIsVersionLine(t *testing.T) {
 			Line:    "## 0.1.0 - 100-10-10",
 			IsValid: false,
 		},
+		{
+			Line:    "## [2.0.0-rc.1] - 2025-01-01",
+			IsValid: true,
+		},
+		{
+			Line:    "## [1.0.0-alpha+001]",
+			IsValid: true,
+		},
+		{
+			Line:    "## [1.0.0-]",
+			IsValid: false,
+		},
+		{
+			Line:    "## [0.0.5] - 2014-12-13 [YANKED]",
+			IsValid: true,
+		},
 	}
 
 	for _, c := range cases {
@@ -162,6 +178,17 @@ func TestParseVersionLine(t *testing.T) {
 			Line:    "## 0.1.0 - 100-10-10",
 			IsValid: false,
 		},
+		{
+			Line:        "## [2.0.0-rc.1] - 2025-10-28",
+			IsValid:     true,
+			Version:     "2.0.0-rc.1",
+			ReleaseDate: &date,
+		},
+		{
+			Line:    "## [1.0.0-beta.2+exp.sha.5114f85]",
+			IsValid: true,
+			Version: "1.0.0-beta.2+exp.sha.5114f85",
+		},
 	}
 
 	for _, c := range cases {
@@ -196,6 +223,43 @@ func TestParseVersionLine(t *testing.T) {
 	}
 }
 
+func TestIsYankedVersionLine(t *testing.T) {
+	cases := []struct {
+		Line    string
+		IsValid bool
+	}{
+		{
+			Line:    "## [0.0.5] - 2014-12-13 [YANKED]",
+			IsValid: true,
+		},
+		{
+			Line:    "## [0.0.5] [YANKED]",
+			IsValid: true,
+		},
+		{
+			Line:    "## [Unreleased] [YANKED]",
+			IsValid: true,
+		},
+		{
+			Line:    "## [0.0.5] - 2014-12-13",
+			IsValid: false,
+		},
+		{
+			Line:    "- [YANKED]",
+			IsValid: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("IsYankedVersionLine(%s)", c.Line), func(t *testing.T) {
+			if ok := IsYankedVersionLine(c.Line); ok != c.IsValid {
+				t.Logf("IsYankedVersionLine(%s). Got %v, wanted %v", c.Line, ok, c.IsValid)
+				t.Fail()
+			}
+		})
+	}
+}
+
 func TestIsSectionLine(t *testing.T) {
 	cases := []struct {
 		Line    string
@@ -336,3 +400,321 @@ func TestParseEntryLine(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLineIndentation(t *testing.T) {
+	cases := []struct {
+		Line        string
+		Indentation int
+	}{
+		{
+			Line:        "- Test",
+			Indentation: 0,
+		},
+		{
+			Line:        "  - Test",
+			Indentation: 2,
+		},
+		{
+			Line:        "	- Test",
+			Indentation: 1,
+		},
+		{
+			Line:        "",
+			Indentation: 0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("GetLineIndentation(%s)", c.Line), func(t *testing.T) {
+			if indentation := GetLineIndentation(c.Line); indentation != c.Indentation {
+				t.Logf("GetLineIndentation(%s). Got %d, wanted %d", c.Line, indentation, c.Indentation)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestIsContinuationLine(t *testing.T) {
+	cases := []struct {
+		Line    string
+		IsValid bool
+	}{
+		{
+			Line:    "  image that will render properly",
+			IsValid: true,
+		},
+		{
+			Line:    "description when links are shared",
+			IsValid: true,
+		},
+		{
+			Line:    "",
+			IsValid: false,
+		},
+		{
+			Line:    "   ",
+			IsValid: false,
+		},
+		{
+			Line:    "- Test",
+			IsValid: false,
+		},
+		{
+			Line:    "### Added",
+			IsValid: false,
+		},
+		{
+			Line:    "## [0.1.0]",
+			IsValid: false,
+		},
+		{
+			Line:    "<!-- changelog-disable-next-line empty-entry -->",
+			IsValid: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("IsContinuationLine(%s)", c.Line), func(t *testing.T) {
+			if ok := IsContinuationLine(c.Line); ok != c.IsValid {
+				t.Logf("IsContinuationLine(%s). Got %v, wanted %v", c.Line, ok, c.IsValid)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestJoinContinuationLine(t *testing.T) {
+	cases := []struct {
+		Description string
+		Line        string
+		Result      string
+	}{
+		{
+			Description: "Replace broken image ",
+			Line:        "  with a new one",
+			Result:      "Replace broken image with a new one",
+		},
+		{
+			Description: "Fix title",
+			Line:        "and description",
+			Result:      "Fix title and description",
+		},
+		{
+			Description: "",
+			Line:        "  Test",
+			Result:      "Test",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("JoinContinuationLine(%s, %s)", c.Description, c.Line), func(t *testing.T) {
+			if result := JoinContinuationLine(c.Description, c.Line); result != c.Result {
+				t.Logf("JoinContinuationLine(%s, %s). Got %s, wanted %s", c.Description, c.Line, result, c.Result)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestIsLinkLine(t *testing.T) {
+	cases := []struct {
+		Line    string
+		IsValid bool
+	}{
+		{
+			Line:    "[1.1.1]: https://github.com/olivierlacan/keep-a-changelog/compare/v1.1.0...v1.1.1",
+			IsValid: true,
+		},
+		{
+			Line:    "[unreleased]: https://github.com/olivierlacan/keep-a-changelog/compare/v1.1.1...HEAD",
+			IsValid: true,
+		},
+		{
+			Line:    "- [1.1.1]: test",
+			IsValid: false,
+		},
+		{
+			Line:    "[1.1.1] test",
+			IsValid: false,
+		},
+		{
+			Line:    "## [1.1.1]",
+			IsValid: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("IsLinkLine(%s)", c.Line), func(t *testing.T) {
+			if ok := IsLinkLine(c.Line); ok != c.IsValid {
+				t.Logf("IsLinkLine(%s). Got %v, wanted %v", c.Line, ok, c.IsValid)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseLinkLine(t *testing.T) {
+	cases := []struct {
+		Line  string
+		Label string
+		URL   string
+	}{
+		{
+			Line:  "[1.1.1]: https://github.com/olivierlacan/keep-a-changelog/compare/v1.1.0...v1.1.1",
+			Label: "1.1.1",
+			URL:   "https://github.com/olivierlacan/keep-a-changelog/compare/v1.1.0...v1.1.1",
+		},
+		{
+			Line:  "[Unreleased]:   <https://example.org/compare/v1.1.1...HEAD>",
+			Label: "Unreleased",
+			URL:   "https://example.org/compare/v1.1.1...HEAD",
+		},
+		{
+			Line: "## [1.1.1]",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("ParseLinkLine(%s)", c.Line), func(t *testing.T) {
+			label, url := ParseLinkLine(c.Line)
+			if label != c.Label || url != c.URL {
+				t.Logf("ParseLinkLine(%s). Got (%s, %s), wanted (%s, %s)", c.Line, label, url, c.Label, c.URL)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseDirectiveLine(t *testing.T) {
+	cases := []struct {
+		Line   string
+		Action string
+		Rules  []string
+	}{
+		{
+			Line:   "<!-- changelog-disable-next-line unsorted-change-type -->",
+			Action: "disable-next-line",
+			Rules:  []string{"unsorted-change-type"},
+		},
+		{
+			Line:   "  <!--changelog-disable CL002, CL004-->",
+			Action: "disable",
+			Rules:  []string{"CL002", "CL004"},
+		},
+		{
+			Line:   "<!-- changelog-enable -->",
+			Action: "enable",
+		},
+		{
+			Line: "<!-- changelog-enabled -->",
+		},
+		{
+			Line: "<!-- a comment -->",
+		},
+		{
+			Line: "- <!-- changelog-disable -->",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("ParseDirectiveLine(%s)", c.Line), func(t *testing.T) {
+			if ok := IsDirectiveLine(c.Line); ok != (c.Action != "") {
+				t.Logf("IsDirectiveLine(%s). Got %v, wanted %v", c.Line, ok, c.Action != "")
+				t.Fail()
+			}
+
+			action, rules := ParseDirectiveLine(c.Line)
+			if action != c.Action || fmt.Sprint(rules) != fmt.Sprint(c.Rules) {
+				t.Logf("ParseDirectiveLine(%s). Got (%s, %v), wanted (%s, %v)", c.Line, action, rules, c.Action, c.Rules)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseFenceLine(t *testing.T) {
+	cases := []struct {
+		Line  string
+		Fence string
+	}{
+		{Line: "```", Fence: "```"},
+		{Line: "````go", Fence: "````"},
+		{Line: "   ~~~", Fence: "~~~"},
+		{Line: "    ```"},
+		{Line: "``"},
+		{Line: "- ```"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("ParseFenceLine(%s)", c.Line), func(t *testing.T) {
+			if fence := ParseFenceLine(c.Line); fence != c.Fence {
+				t.Logf("ParseFenceLine(%s). Got %s, wanted %s", c.Line, fence, c.Fence)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestIsTextLine(t *testing.T) {
+	cases := []struct {
+		Line    string
+		IsValid bool
+	}{
+		{
+			Line:    "All notable changes to this project will be documented in this file.",
+			IsValid: true,
+		},
+		{
+			Line:    "",
+			IsValid: true,
+		},
+		{
+			Line:    "#### Upgrade notes",
+			IsValid: true,
+		},
+		{
+			Line:    "# Changelog",
+			IsValid: false,
+		},
+		{
+			Line:    "## [1.0.0]",
+			IsValid: false,
+		},
+		{
+			Line:    "### Added",
+			IsValid: false,
+		},
+		{
+			Line:    "- Test",
+			IsValid: false,
+		},
+		{
+			Line:    "[1.0.0]: https://example.org",
+			IsValid: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("IsTextLine(%s)", c.Line), func(t *testing.T) {
+			if ok := IsTextLine(c.Line); ok != c.IsValid {
+				t.Logf("IsTextLine(%s). Got %v, wanted %v", c.Line, ok, c.IsValid)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAppendTextLine(t *testing.T) {
+	text := ""
+	for _, line := range []string{"", "  ", "First paragraph", "continued.", "", "", "Second paragraph.", ""} {
+		text = AppendTextLine(text, line)
+	}
+
+	if text != "First paragraph\ncontinued.\n\nSecond paragraph.\n" {
+		t.Fatalf("Unexpected text: %q", text)
+	}
+
+	if text = TrimText(text); text != "First paragraph\ncontinued.\n\nSecond paragraph." {
+		t.Fatalf("Unexpected trimmed text: %q", text)
+	}
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"regexp"
+	"strconv"
+)
+
+var semverRegex = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// SemVer is a parsed SemVer 2.0 version
+type SemVer struct {
+	Major      int
+	Minor      int
+	Patch      int
+	PreRelease string
+	Build      string
+}
+
+func IsValidSemVer(version string) bool {
+	return semverRegex.MatchString(version)
+}
+
+// ParseSemVer parses the SemVer 2.0 version (ok is false if the version is invalid)
+func ParseSemVer(version string) (SemVer, bool) {
+	matches := semverRegex.FindStringSubmatch(version)
+	if len(matches) == 0 {
+		return SemVer{}, false
+	}
+
+	major, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return SemVer{}, false
+	}
+	minor, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return SemVer{}, false
+	}
+	patch, err := strconv.Atoi(matches[3])
+	if err != nil {
+		return SemVer{}, false
+	}
+
+	return SemVer{
+		Major:      major,
+		Minor:      minor,
+		Patch:      patch,
+		PreRelease: matches[4],
+		Build:      matches[5],
+	}, true
+}
+
+func (v SemVer) String() string {
+	s := strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor) + "." + strconv.Itoa(v.Patch)
+
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+
+	return s
+}
@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIsValidSemVer(t *testing.T) {
+	cases := []struct {
+		Version string
+		IsValid bool
+	}{
+		{Version: "1.0.0", IsValid: true},
+		{Version: "2.0.0-rc.1", IsValid: true},
+		{Version: "1.0.0-alpha+001", IsValid: true},
+		{Version: "1.0", IsValid: false},
+		{Version: "01.0.0", IsValid: false},
+		{Version: "Unreleased", IsValid: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("IsValidSemVer(%s)", c.Version), func(t *testing.T) {
+			if ok := IsValidSemVer(c.Version); ok != c.IsValid {
+				t.Logf("IsValidSemVer(%s). Got %v, wanted %v", c.Version, ok, c.IsValid)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseSemVer(t *testing.T) {
+	v, ok := ParseSemVer("1.2.3-rc.1+build.5")
+	if !ok {
+		t.Fatal("ParseSemVer() failed")
+	}
+
+	if v.Major != 1 || v.Minor != 2 || v.Patch != 3 || v.PreRelease != "rc.1" || v.Build != "build.5" {
+		t.Fatalf("ParseSemVer() returned wrong value: %v", v)
+	}
+
+	if v.String() != "1.2.3-rc.1+build.5" {
+		t.Fatalf("String() returned wrong value: %s", v.String())
+	}
+
+	if _, ok := ParseSemVer("1.2"); ok {
+		t.Fatal("ParseSemVer() should fail on invalid version")
+	}
+}
@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseYAML parses the first YAML document. Mappings are returned as map[string]any, sequences as []any, scalars as
+// string (their raw value, e.g. `true` or `1`) and null values as nil.
+func ParseYAML(b []byte) (any, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(b, &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+
+	return yamlValue(document.Content[0])
+}
+
+func yamlValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		m := make(map[string]any, len(node.Content)/2)
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("line %d: mapping keys must be scalars", key.Line)
+			}
+
+			if _, exists := m[key.Value]; exists {
+				return nil, fmt.Errorf("line %d: duplicate key: %s", key.Line, key.Value)
+			}
+
+			value, err := yamlValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			m[key.Value] = value
+		}
+
+		return m, nil
+	case yaml.SequenceNode:
+		values := make([]any, 0, len(node.Content))
+
+		for _, item := range node.Content {
+			value, err := yamlValue(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+
+		return values, nil
+	case yaml.AliasNode:
+		return yamlValue(node.Alias)
+	default:
+		if node.ShortTag() == "!!null" {
+			return nil, nil
+		}
+
+		return node.Value, nil
+	}
+}
@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseYAML(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Value string
+		Want  any
+	}{
+		{
+			Name:  "empty document",
+			Value: "# Nothing here\n",
+			Want:  nil,
+		},
+		{
+			Name:  "scalars",
+			Value: "---\ntype: fixed # comment\ndescription: \"Fix the #1 crash.\"\nquoted: 'It''s ok'\nempty:\nnull: ~\n",
+			Want: map[string]any{
+				"type":        "fixed",
+				"description": "Fix the #1 crash.",
+				"quoted":      "It's ok",
+				"empty":       nil,
+				"null":        nil,
+			},
+		},
+		{
+			Name:  "nested mappings and sequences",
+			Value: "validator:\n  allow-empty-version: true\n  rules:\n    - CL001\n    - CL002\nchange-types: [Added, \"Fixed\"]\nitems:\n- name: a\n  weight: 1\n- name: b\n",
+			Want: map[string]any{
+				"validator": map[string]any{
+					"allow-empty-version": "true",
+					"rules":               []any{"CL001", "CL002"},
+				},
+				"change-types": []any{"Added", "Fixed"},
+				"items": []any{
+					map[string]any{"name": "a", "weight": "1"},
+					map[string]any{"name": "b"},
+				},
+			},
+		},
+		{
+			Name:  "block scalars",
+			Value: "literal: |\n  First line.\n    Indented line.\n\nfolded: >-\n  Folded\n  line.\n\n  New paragraph.\nlast: value\n",
+			Want: map[string]any{
+				"literal": "First line.\n  Indented line.\n",
+				"folded":  "Folded line.\nNew paragraph.",
+				"last":    "value",
+			},
+		},
+		{
+			Name:  "flow mappings and anchors",
+			Value: "aliases: &aliases {Fix: Fixed, New: Added}\nlinter:\n  aliases: *aliases\n",
+			Want: map[string]any{
+				"aliases": map[string]any{"Fix": "Fixed", "New": "Added"},
+				"linter":  map[string]any{"aliases": map[string]any{"Fix": "Fixed", "New": "Added"}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			got, err := ParseYAML([]byte(c.Value))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, c.Want) {
+				t.Logf("ParseYAML(%q). Got: %#v, wanted: %#v", c.Value, got, c.Want)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseYAMLErrors(t *testing.T) {
+	cases := []string{
+		"key: \"unterminated\n",
+		"key: value\n  other: value\n",
+		"key: value\nkey: value\n",
+		"\tkey: value\n",
+		"key: [a, b\n",
+	}
+
+	for _, c := range cases {
+		if _, err := ParseYAML([]byte(c)); err == nil {
+			t.Logf("ParseYAML(%q) should have failed", c)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package validateachangelog
+
+import "strings"
+
+// LinkTemplates contains the URL templates of the version links, {previous} and {current} being replaced by the
+// version tags (HEAD for Unreleased)
+type LinkTemplates struct {
+	// Compare is the URL comparing a version to the previous one (e.g. https://github.com/o/r/compare/{previous}...{current})
+	Compare string
+	// Initial is the URL of the first version (e.g. https://github.com/o/r/releases/tag/{current})
+	Initial string
+}
+
+// GenerateLinks creates the missing version links from the templates, the tags being named following the pattern
+// (DefaultTagPattern when empty). Existing links are kept.
+func (c *Changelog) GenerateLinks(templates LinkTemplates, tagPattern string) {
+	// New links are registered next to the existing version ones
+	index := c.Links.Len()
+	for _, version := range c.Versions {
+		if i := c.linkIndex(version.Version); i != -1 {
+			index = i
+			break
+		}
+	}
+
+	for i, version := range c.Versions {
+		if j := c.linkIndex(version.Version); j != -1 {
+			index = j + 1
+			continue
+		}
+
+		current := "HEAD"
+		if version.Version != UnreleasedVersion {
+			current = TagName(tagPattern, version.Version)
+		}
+
+		url := ""
+		if i < len(c.Versions)-1 {
+			url = strings.NewReplacer("{previous}", TagName(tagPattern, c.Versions[i+1].Version), "{current}", current).Replace(templates.Compare)
+		} else if version.Version != UnreleasedVersion {
+			url = strings.ReplaceAll(templates.Initial, "{current}", current)
+		}
+
+		if url == "" {
+			continue
+		}
+
+		_ = c.Links.Insert(index, version.Version, url)
+		version.URL = url
+		index++
+	}
+}
+
+// linkIndex returns the position of the link matching the label (case-insensitive), -1 if there is none
+func (c *Changelog) linkIndex(label string) int {
+	for i, key := range c.Links.Keys() {
+		if strings.EqualFold(key, label) {
+			return i
+		}
+	}
+
+	return -1
+}
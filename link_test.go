@@ -0,0 +1,61 @@
+package validateachangelog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChangelogGenerateLinks(t *testing.T) {
+	c := &Changelog{
+		Versions: []*Version{
+			{Version: "Unreleased"},
+			{Version: "1.1.0"},
+			{Version: "1.0.0"},
+			{Version: "0.1.0"},
+		},
+	}
+
+	_ = c.Links.Set("1.0.0", "https://example.org/custom")
+	_ = c.Links.Set("Keep", "https://keepachangelog.com")
+
+	c.GenerateLinks(LinkTemplates{
+		Compare: "https://example.org/compare/{previous}...{current}",
+		Initial: "https://example.org/releases/tag/{current}",
+	}, "")
+
+	if keys := strings.Join(c.Links.Keys(), ","); keys != "Unreleased,1.1.0,1.0.0,0.1.0,Keep" {
+		t.Fatalf("Unexpected links: %s", keys)
+	}
+
+	wanted := map[string]string{
+		"Unreleased": "https://example.org/compare/v1.1.0...HEAD",
+		"1.1.0":      "https://example.org/compare/v1.0.0...v1.1.0",
+		"1.0.0":      "https://example.org/custom",
+		"0.1.0":      "https://example.org/releases/tag/v0.1.0",
+	}
+
+	for label, url := range wanted {
+		if got, _ := c.Links.Get(label); got != url {
+			t.Logf("Unexpected link [%s]. Got: %s, wanted: %s", label, got, url)
+			t.Fail()
+		}
+	}
+
+	if c.Versions[0].URL != wanted["Unreleased"] {
+		t.Fatalf("Unexpected Unreleased URL: %s", c.Versions[0].URL)
+	}
+}
+
+func TestChangelogGenerateLinksWithoutTemplates(t *testing.T) {
+	c := &Changelog{
+		Versions: []*Version{
+			{Version: "1.0.0"},
+		},
+	}
+
+	c.GenerateLinks(LinkTemplates{}, "")
+
+	if c.Links.Len() != 0 {
+		t.Fatalf("Expected no links. Got: %v", c.Links.Keys())
+	}
+}
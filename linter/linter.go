@@ -14,30 +14,102 @@ import (
 )
 
 var (
-	versionRegex           = regexp.MustCompile(`^## \[?([0-9.]+)\]? ?-? ?([0-9]{4}-[0-9]{2}-[0-9]{2})?$`)
-	frenchVersionRegex     = regexp.MustCompile(`^## \[?([0-9.]+)\]? ?-? ?([0-9]{2}-[0-9]{2}-[0-9]{4})?$`)
+	versionRegex           = regexp.MustCompile(`^## \[?([0-9.]+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?)\]?(?: ?-? ?(.+))?$`)
 	unreleasedVersionRegex = regexp.MustCompile(`^## \[?Unreleased\]?$`)
+	yankedVersionRegex     = regexp.MustCompile(`(?i) *\[?yanked\]?$`)
 )
 
-func Lint(r io.Reader) (*validateachangelog.Changelog, error) {
-	c := &validateachangelog.Changelog{}
+type Options struct {
+	// ChangeTypes contains the allowed change types (validateachangelog.DefaultChangeTypes when nil). Sections matching
+	// them case-insensitively are renamed.
+	ChangeTypes validateachangelog.ChangeTypes
+	// Aliases maps (case-insensitive) section names to the change type they are renamed to (DefaultAliases when nil)
+	Aliases map[string]string
+	// DateFormats contains the layouts (see time.Parse) of the release dates to recover (DefaultDateFormats when nil)
+	DateFormats []string
+}
+
+// DefaultDateFormats returns the default layouts of the release dates to recover (2025-10-28 and 28-10-2025)
+func DefaultDateFormats() []string {
+	return []string{"2006-01-02", "02-01-2006"}
+}
+
+// DefaultAliases returns the default section aliases (e.g. Fix -> Fixed)
+func DefaultAliases() map[string]string {
+	return map[string]string{
+		"fix":    "Fixed",
+		"change": "Changed",
+		"new":    "Added",
+	}
+}
+
+func Lint(r io.Reader, opts *Options) (*validateachangelog.Changelog, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	changeTypes := opts.ChangeTypes
+	if changeTypes == nil {
+		changeTypes = validateachangelog.DefaultChangeTypes()
+	}
+
+	aliases := opts.Aliases
+	if aliases == nil {
+		aliases = DefaultAliases()
+	}
+
+	dateFormats := opts.DateFormats
+	if dateFormats == nil {
+		dateFormats = DefaultDateFormats()
+	}
+
+	c := &validateachangelog.Changelog{
+		Links:         *internal.NewEmptyMap[string, string](),
+		LinkPositions: map[string]validateachangelog.Position{},
+	}
 
 	currentVersion := &validateachangelog.Version{
-		Version:     "",
-		ReleaseDate: &time.Time{},
-		Entries:     *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+		Version:          "",
+		ReleaseDate:      &time.Time{},
+		Entries:          *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+		SectionPositions: map[string]validateachangelog.Position{},
 	}
 	currentSection := ""
 
-	standardChangeTypes := internal.GetStandardChangeTypes()
+	lineNumber := 0
+	inEntry := false
+	// Indentation of the current entry and its parents (used to build the entries tree)
+	var entryIndentations []int
+	// Number of directives waiting for the line they precede
+	pendingDirectives := 0
 
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		line := scanner.Text()
+		lineNumber++
+
+		// Anchor the pending directives to the line they precede
+		if pendingDirectives > 0 && strings.TrimSpace(line) != "" && !internal.IsDirectiveLine(line) {
+			for i := len(c.Directives) - pendingDirectives; i < len(c.Directives); i++ {
+				c.Directives[i].Target = validateachangelog.Position{Line: lineNumber, Column: internal.GetLineIndentation(line) + 1}
+			}
+			pendingDirectives = 0
+		}
+
+		// Parse entry continuation (wrapped line of the previous entry)
+		if inEntry && internal.IsContinuationLine(line) {
+			currentVersionEntries, _ := currentVersion.Entries.Get(currentSection)
+			lastEntry := validateachangelog.LastEntry(currentVersionEntries)
+			lastEntry.Description = internal.JoinContinuationLine(lastEntry.Description, line)
+
+			continue
+		}
+		inEntry = false
 
 		// Parse title
 		if internal.IsTitleLine(line) {
 			c.Title = internal.ParseTitleLine(line)
+			c.TitlePosition = validateachangelog.Position{Line: lineNumber, Column: 1}
 		}
 
 		// Parse version
@@ -46,9 +118,10 @@ func Lint(r io.Reader) (*validateachangelog.Changelog, error) {
 			if currentVersion.Version != "" {
 				c.Versions = append(c.Versions, currentVersion)
 				currentVersion = &validateachangelog.Version{
-					Version:     "",
-					ReleaseDate: &time.Time{},
-					Entries:     *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+					Version:          "",
+					ReleaseDate:      &time.Time{},
+					Entries:          *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+					SectionPositions: map[string]validateachangelog.Position{},
 				}
 				currentSection = ""
 			}
@@ -56,6 +129,10 @@ func Lint(r io.Reader) (*validateachangelog.Changelog, error) {
 			var version string
 			var releaseDate *time.Time
 
+			// Handle yanked version (strip the flag before recovering the line)
+			yanked := yankedVersionRegex.MatchString(line)
+			line = yankedVersionRegex.ReplaceAllString(line, "")
+
 			// Determinate whether it is a valid line
 			if internal.IsVersionLine(line) {
 				version, releaseDate, _ = internal.ParseVersionLine(line)
@@ -64,29 +141,14 @@ func Lint(r io.Reader) (*validateachangelog.Changelog, error) {
 				currentVersion.ReleaseDate = releaseDate
 			} else {
 				// Try to manually recover the line
-				if versionRegex.MatchString(line) {
-					parts := versionRegex.FindStringSubmatch(line)
-					if len(parts) > 1 {
-						version = parts[1]
-					}
-					if len(parts) > 2 && parts[2] != "" {
-						t, err := time.Parse("2006-01-02", parts[2])
-						if err == nil {
-							releaseDate = &t
-						}
-					}
-				} else if frenchVersionRegex.MatchString(line) {
-					parts := frenchVersionRegex.FindStringSubmatch(line)
-					if len(parts) > 1 {
+				if parts := versionRegex.FindStringSubmatch(line); len(parts) > 2 {
+					if date, ok := parseReleaseDate(strings.Trim(parts[2], " -"), dateFormats); ok {
 						version = parts[1]
+						releaseDate = date
 					}
-					if len(parts) > 2 && parts[2] != "" {
-						t, err := time.Parse("02-01-2006", parts[2])
-						if err == nil {
-							releaseDate = &t
-						}
-					}
-				} else if unreleasedVersionRegex.MatchString(line) || len(c.Versions) == 0 {
+				}
+
+				if version == "" && (unreleasedVersionRegex.MatchString(line) || len(c.Versions) == 0) {
 					version = "Unreleased"
 				}
 			}
@@ -98,23 +160,12 @@ func Lint(r io.Reader) (*validateachangelog.Changelog, error) {
 
 			currentVersion.Version = version
 			currentVersion.ReleaseDate = releaseDate
+			currentVersion.Yanked = yanked
+			currentVersion.Position = validateachangelog.Position{Line: lineNumber, Column: 1}
 		} else if internal.IsSectionLine(line) {
 			// Parse section (Added, Changed, Removed, Fixed)
 
-			currentSection = internal.ParseSectionLine(line)
-
-			// Hardcoded mapping
-			// TODO: How can we improve/extended this?
-			if _, exists := standardChangeTypes[currentSection]; !exists {
-				switch strings.ToLower(currentSection) {
-				case "fix":
-					currentSection = "Fixed"
-				case "change":
-					currentSection = "Changed"
-				case "new":
-					currentSection = "Added"
-				}
-			}
+			currentSection = normalizeSection(internal.ParseSectionLine(line), changeTypes, aliases)
 
 			if currentVersion.Version == "" {
 				return nil, fmt.Errorf("invalid changelog section: %s (no version found)", line)
@@ -122,30 +173,57 @@ func Lint(r io.Reader) (*validateachangelog.Changelog, error) {
 
 			if !currentVersion.Entries.Has(currentSection) {
 				_ = currentVersion.Entries.Set(currentSection, []validateachangelog.Entry{})
+				currentVersion.SectionPositions[currentSection] = validateachangelog.Position{Line: lineNumber, Column: 1}
 			}
+
+			entryIndentations = nil
 		} else if internal.IsEntryLine(line) {
 			// Parse entry
 			entry := internal.ParseEntryLine(line)
 
-			if !strings.HasSuffix(entry, ".") {
-				entry = fmt.Sprintf("%s.", entry)
-			}
-
 			if currentSection == "" {
 				return nil, fmt.Errorf("invalid changelog entry: %s (no section found)", line)
 			}
 
+			// Compute the entry depth using the indentation of the previous entries
+			indentation := internal.GetLineIndentation(line)
+			for len(entryIndentations) > 0 && entryIndentations[len(entryIndentations)-1] >= indentation {
+				entryIndentations = entryIndentations[:len(entryIndentations)-1]
+			}
+			depth := len(entryIndentations)
+			entryIndentations = append(entryIndentations, indentation)
+
 			// Todo: optimise?
 			currentVersionEntries, _ := currentVersion.Entries.Get(currentSection)
-			currentVersionEntries = append(currentVersionEntries, validateachangelog.Entry{
+			currentVersionEntries = validateachangelog.AppendEntry(currentVersionEntries, depth, validateachangelog.Entry{
 				Description: entry,
+				Position:    validateachangelog.Position{Line: lineNumber, Column: indentation + 1},
 			})
 			_ = currentVersion.Entries.Set(currentSection, currentVersionEntries)
-		} else if strings.Trim(line, " ") != "" && !internal.IsTitleLine(line) {
-			if !strings.HasSuffix(line, ".") {
-				line = fmt.Sprintf("%s.", line)
+
+			inEntry = true
+		} else if internal.IsLinkLine(line) {
+			// Parse link reference definition (first definition wins)
+			label, url := internal.ParseLinkLine(line)
+
+			if !c.Links.Has(label) {
+				_ = c.Links.Set(label, url)
+				c.LinkPositions[label] = validateachangelog.Position{Line: lineNumber, Column: internal.GetLineIndentation(line) + 1}
 			}
+		} else if internal.IsDirectiveLine(line) {
+			// Keep the validation directives (instead of converting them to entries), written back before their target
+			action, rules := internal.ParseDirectiveLine(line)
 
+			c.Directives = append(c.Directives, validateachangelog.Directive{
+				Action:   action,
+				Rules:    rules,
+				Position: validateachangelog.Position{Line: lineNumber, Column: internal.GetLineIndentation(line) + 1},
+			})
+			pendingDirectives++
+		} else if currentVersion.Version == "" && !internal.IsTitleLine(line) {
+			// Parse free-form text preceding the first version
+			c.Description = internal.AppendTextLine(c.Description, line)
+		} else if strings.Trim(line, " ") != "" && !internal.IsTitleLine(line) {
 			if currentSection == "" {
 				currentSection = "Added"
 			}
@@ -157,12 +235,17 @@ func Lint(r io.Reader) (*validateachangelog.Changelog, error) {
 			// Todo: optimise?
 			currentVersionEntries, _ := currentVersion.Entries.Get(currentSection)
 			currentVersionEntries = append(currentVersionEntries, validateachangelog.Entry{
-				Description: line,
+				Description: strings.TrimSpace(line),
+				Position:    validateachangelog.Position{Line: lineNumber, Column: internal.GetLineIndentation(line) + 1},
 			})
 			_ = currentVersion.Entries.Set(currentSection, currentVersionEntries)
+
+			inEntry = true
 		}
 	}
 
+	c.Description = internal.TrimText(c.Description)
+
 	// Push the latest version (if any)
 	if currentSection != "" {
 		c.Versions = append(c.Versions, currentVersion)
@@ -176,10 +259,27 @@ func Lint(r io.Reader) (*validateachangelog.Changelog, error) {
 		return nil, fmt.Errorf("no versions found in changelog")
 	}
 
+	// Resolve version links
+	for _, version := range c.Versions {
+		version.URL, _ = c.GetLink(version.Version)
+	}
+
+	// Make sure entries end with a period (once wrapped lines are joined)
+	for _, version := range c.Versions {
+		for _, changeType := range version.Entries.Keys() {
+			entries, _ := version.Entries.Get(changeType)
+			validateachangelog.WalkEntries(entries, func(entry *validateachangelog.Entry, _ int) {
+				if !strings.HasSuffix(entry.Description, ".") {
+					entry.Description = fmt.Sprintf("%s.", entry.Description)
+				}
+			})
+		}
+	}
+
 	return c, nil
 }
 
-func LintFile(filename string) (*validateachangelog.Changelog, error) {
+func LintFile(filename string, opts *Options) (*validateachangelog.Changelog, error) {
 	f, err := os.Open(filename)
 	if err != nil {
 		return nil, err
@@ -188,5 +288,35 @@ func LintFile(filename string) (*validateachangelog.Changelog, error) {
 		_ = f.Close()
 	}()
 
-	return Lint(f)
+	return Lint(f, opts)
+}
+
+// normalizeSection renames the section following the aliases and the (case-insensitive) change types
+func normalizeSection(section string, changeTypes validateachangelog.ChangeTypes, aliases map[string]string) string {
+	if changeTypes.Has(section) {
+		return section
+	}
+
+	for alias, changeType := range aliases {
+		if strings.EqualFold(alias, section) {
+			return changeType
+		}
+	}
+
+	return changeTypes.Normalize(section)
+}
+
+// parseReleaseDate parses the release date using the first matching layout (a missing date being valid)
+func parseReleaseDate(date string, dateFormats []string) (*time.Time, bool) {
+	if date == "" {
+		return nil, true
+	}
+
+	for _, layout := range dateFormats {
+		if t, err := time.Parse(layout, date); err == nil {
+			return &t, true
+		}
+	}
+
+	return nil, false
 }
@@ -1 +1,119 @@
 package linter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+)
+
+func TestLintContinuationLines(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## 1.0.0 - 2025-10-28\n\n### Fix\n\n- Remove exclusionary mentions of \"open source\" since this project can\n  benefit both \"open\" and \"closed\" source projects equally\n- Another fix\n")
+	c, err := Lint(r, nil)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	entries, _ := c.Versions[0].Entries.Get("Fixed")
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 fixed entries. Got: %d", len(entries))
+	}
+
+	if entries[0].Description != "Remove exclusionary mentions of \"open source\" since this project can benefit both \"open\" and \"closed\" source projects equally." {
+		t.Logf("Unexpected entry description: \"%s\"", entries[0].Description)
+		t.Fail()
+	}
+	if entries[1].Description != "Another fix." {
+		t.Logf("Unexpected entry description: \"%s\"", entries[1].Description)
+		t.Fail()
+	}
+}
+
+func TestLintYankedVersion(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## 0.0.5 - 13-12-2014 [yanked]\n\n### Added\n\n- Test\n")
+	c, err := Lint(r, nil)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	if c.Versions[0].Version != "0.0.5" || !c.Versions[0].Yanked || c.Versions[0].ReleaseDate == nil {
+		t.Fatalf("Expected yanked 0.0.5 version with release date. Got: %v", c.Versions[0])
+	}
+}
+
+func TestLintDescription(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\nAll notable changes to this project will be documented in this file.\n\n## 1.0.0 - 2025-10-28\n\n### Added\n\n- Test\n")
+	c, err := Lint(r, nil)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	if c.Description != "All notable changes to this project will be documented in this file." {
+		t.Logf("Unexpected description: %q", c.Description)
+		t.Fail()
+	}
+
+	if entries, _ := c.Versions[0].Entries.Get("Added"); len(entries) != 1 {
+		t.Logf("Expected 1 added entry. Got: %v", entries)
+		t.Fail()
+	}
+}
+
+func TestLintDirectives(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## 1.0.0 - 2025-10-28\n\n<!-- changelog-disable-next-line CL004 -->\n\n### Fixed\n\n- Test\n")
+	c, err := Lint(r, nil)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	// The directive precedes the section (blank lines aside) and is not kept as notes
+	if len(c.Directives) != 1 || c.Directives[0].Target.Line != 7 || c.Versions[0].Notes != "" {
+		t.Fatalf("Unexpected directives: %+v (notes: %q)", c.Directives, c.Versions[0].Notes)
+	}
+}
+
+func TestLintChangeTypes(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## 1.0.0 - 2025-10-28\n\n### Perf\n\n- Faster\n\n### fixed\n\n- Bug\n\n### Fix\n\n- Another bug\n")
+	c, err := Lint(r, &Options{
+		ChangeTypes: validateachangelog.NewChangeTypes("Added", "Performance", "Fixed"),
+		Aliases:     map[string]string{"Perf": "Performance"},
+	})
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	keys := c.Versions[0].Entries.Keys()
+	if strings.Join(keys, ",") != "Performance,Fixed,Fix" {
+		t.Fatalf("Unexpected sections: %v", keys)
+	}
+}
+
+func TestLintDateFormats(t *testing.T) {
+	cases := []struct {
+		Line        string
+		DateFormats []string
+		Date        string
+	}{
+		{Line: "## 1.0.0 - 2025-10-28", Date: "2025-10-28"},
+		{Line: "## [1.0.0] 28-10-2025", Date: "2025-10-28"},
+		{Line: "## 1.0.0 -", Date: ""},
+		{Line: "## 1.0.0 - October 28, 2025", DateFormats: []string{"January 2, 2006"}, Date: "2025-10-28"},
+	}
+
+	for _, c := range cases {
+		changelog, err := Lint(strings.NewReader("# Changelog\n\n"+c.Line+"\n\n### Added\n\n- Test\n"), &Options{DateFormats: c.DateFormats})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		date := ""
+		if releaseDate := changelog.Versions[0].ReleaseDate; releaseDate != nil {
+			date = releaseDate.Format("2006-01-02")
+		}
+
+		if changelog.Versions[0].Version != "1.0.0" || date != c.Date {
+			t.Logf("Lint(%s). Got (%s, %s), wanted (1.0.0, %s)", c.Line, changelog.Versions[0].Version, date, c.Date)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+var goVersionRegex = regexp.MustCompile(`(?m)^\s*(?:(?:const|var)\s+)?[Vv]ersion\s*(?:string\s*)?=\s*"([^"]+)"`)
+
+// Extractor returns the version declared by the manifest content
+type Extractor func(b []byte) (string, error)
+
+type registration struct {
+	pattern   string
+	extractor Extractor
+}
+
+var (
+	mutex      sync.RWMutex
+	extractors = []registration{
+		{pattern: "package.json", extractor: ExtractPackageJSON},
+		{pattern: "Cargo.toml", extractor: ExtractCargoToml},
+		{pattern: "pyproject.toml", extractor: ExtractPyprojectToml},
+		{pattern: "Chart.yaml", extractor: ExtractChartYaml},
+		{pattern: "VERSION", extractor: ExtractVersionFile},
+		{pattern: "*.go", extractor: ExtractGoConstant},
+	}
+)
+
+// Register registers the extractor of the manifests whose file name matches the pattern (see filepath.Match).
+// Extractors registered last take precedence.
+func Register(pattern string, extractor Extractor) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	extractors = append(extractors, registration{pattern: pattern, extractor: extractor})
+}
+
+// ReadVersion returns the version declared by the manifest file
+func ReadVersion(filename string) (string, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	return ExtractVersion(filepath.Base(filename), b)
+}
+
+// ExtractVersion returns the version declared by the manifest content, using the extractor matching the file name.
+// The `v` prefix of the version (if any) is removed.
+func ExtractVersion(filename string, b []byte) (string, error) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	for i := len(extractors) - 1; i >= 0; i-- {
+		if matched, _ := filepath.Match(extractors[i].pattern, filename); !matched {
+			continue
+		}
+
+		version, err := extractors[i].extractor(b)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", filename, err)
+		}
+
+		version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+		if version == "" {
+			return "", fmt.Errorf("%s: no version found", filename)
+		}
+
+		return version, nil
+	}
+
+	return "", fmt.Errorf("unsupported manifest: %s", filename)
+}
+
+// ExtractPackageJSON returns the `version` of a package.json (npm)
+func ExtractPackageJSON(b []byte) (string, error) {
+	var manifest struct {
+		Version string `json:"version"`
+	}
+
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return "", err
+	}
+
+	return manifest.Version, nil
+}
+
+// ExtractCargoToml returns the `version` of the [package] (or [workspace.package]) table of a Cargo.toml
+func ExtractCargoToml(b []byte) (string, error) {
+	return extractTomlString(b, "version", "package", "workspace.package")
+}
+
+// ExtractPyprojectToml returns the `version` of the [project] (or [tool.poetry]) table of a pyproject.toml
+func ExtractPyprojectToml(b []byte) (string, error) {
+	return extractTomlString(b, "version", "project", "tool.poetry")
+}
+
+// ExtractChartYaml returns the `version` of a Chart.yaml (Helm)
+func ExtractChartYaml(b []byte) (string, error) {
+	value, err := internal.ParseYAML(b)
+	if err != nil {
+		return "", err
+	}
+
+	m, _ := value.(map[string]any)
+	version, _ := m["version"].(string)
+
+	return version, nil
+}
+
+// ExtractVersionFile returns the content of a VERSION file
+func ExtractVersionFile(b []byte) (string, error) {
+	return string(b), nil
+}
+
+// ExtractGoConstant returns the value of the `Version` constant (or variable) of a Go source file
+func ExtractGoConstant(b []byte) (string, error) {
+	matches := goVersionRegex.FindSubmatch(b)
+	if len(matches) == 0 {
+		return "", nil
+	}
+
+	return string(matches[1]), nil
+}
+
+// extractTomlString returns the string value of the key in the first of the tables defining it
+func extractTomlString(b []byte, key string, tables ...string) (string, error) {
+	values := map[string]string{}
+	table := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "[") {
+			table = strings.TrimSpace(strings.Trim(line, "[]"))
+			continue
+		}
+
+		k, v, found := strings.Cut(line, "=")
+		if !found || strings.TrimSpace(k) != key {
+			continue
+		}
+
+		v = strings.TrimSpace(v)
+		if len(v) < 2 || (v[0] != '"' && v[0] != '\'') {
+			continue
+		}
+
+		if end := strings.IndexByte(v[1:], v[0]); end != -1 {
+			if _, exists := values[table]; !exists {
+				values[table] = v[1 : end+1]
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	for _, t := range tables {
+		if value, exists := values[t]; exists {
+			return value, nil
+		}
+	}
+
+	return "", nil
+}
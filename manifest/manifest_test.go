@@ -0,0 +1,63 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExtractVersion(t *testing.T) {
+	cases := []struct {
+		Filename string
+		Value    string
+		Version  string
+	}{
+		{Filename: "package.json", Value: `{"name": "app", "version": "1.2.0"}`, Version: "1.2.0"},
+		{Filename: "Cargo.toml", Value: "[dependencies]\nversion = \"0.1.0\"\n\n[package]\nname = \"app\"\nversion = \"1.2.0\" # comment\n", Version: "1.2.0"},
+		{Filename: "Cargo.toml", Value: "[workspace.package]\nversion = '2.0.0-rc.1'\n", Version: "2.0.0-rc.1"},
+		{Filename: "pyproject.toml", Value: "[tool.poetry]\nversion = \"1.0.0\"\n\n[project]\nversion = \"1.2.0\"\n", Version: "1.2.0"},
+		{Filename: "Chart.yaml", Value: "apiVersion: v2\nname: app\nversion: 1.2.0\nappVersion: \"3.0.0\"\n", Version: "1.2.0"},
+		{Filename: "VERSION", Value: "v1.2.0\n", Version: "1.2.0"},
+		{Filename: "version.go", Value: "package app\n\n// Version of the app\nconst Version = \"1.2.0\"\n", Version: "1.2.0"},
+		{Filename: "version.go", Value: "package main\n\n// Version is set at build time (-ldflags)\nvar Version = \"1.2.3\"\n", Version: "1.2.3"},
+	}
+
+	for _, c := range cases {
+		version, err := ExtractVersion(c.Filename, []byte(c.Value))
+		if err != nil || version != c.Version {
+			t.Logf("ExtractVersion(%s). Got (%s, %v), wanted %s", c.Filename, version, err, c.Version)
+			t.Fail()
+		}
+	}
+}
+
+func TestExtractVersionErrors(t *testing.T) {
+	cases := map[string]string{
+		"package.json": `{"name": "app"}`,
+		"Cargo.toml":   "[package]\nname = \"app\"\n",
+		"build.gradle": "version = '1.2.0'",
+	}
+
+	for filename, value := range cases {
+		if _, err := ExtractVersion(filename, []byte(value)); err == nil {
+			t.Logf("ExtractVersion(%s) should have failed", filename)
+			t.Fail()
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register("*.xml", func(b []byte) (string, error) {
+		return "1.2.0", nil
+	})
+
+	filename := filepath.Join(t.TempDir(), "pom.xml")
+	if err := os.WriteFile(filename, []byte("<version>1.2.0</version>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	version, err := ReadVersion(filename)
+	if err != nil || version != "1.2.0" {
+		t.Fatalf("Unexpected version. Got (%s, %v)", version, err)
+	}
+}
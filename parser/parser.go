@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/vold-lu/validate-a-changelog"
@@ -12,33 +13,85 @@ import (
 )
 
 func Parse(r io.Reader) (*validateachangelog.Changelog, error) {
-	c := &validateachangelog.Changelog{}
+	c := &validateachangelog.Changelog{
+		Links:         *internal.NewEmptyMap[string, string](),
+		LinkPositions: map[string]validateachangelog.Position{},
+	}
 
 	currentVersion := &validateachangelog.Version{
-		Version:     "",
-		ReleaseDate: &time.Time{},
-		Entries:     *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+		Version:          "",
+		ReleaseDate:      &time.Time{},
+		Entries:          *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+		SectionPositions: map[string]validateachangelog.Position{},
+		SectionNotes:     map[string]string{},
 	}
 	currentSection := ""
 
+	lineNumber := 0
+	inEntry := false
+	// Indentation of the current entry and its parents (used to build the entries tree)
+	var entryIndentations []int
+	// Number of directives waiting for the line they precede
+	pendingDirectives := 0
+	// Fence of the current fenced code block (empty outside of code blocks)
+	fence := ""
+
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		line := scanner.Text()
+		lineNumber++
+
+		// Fenced code blocks (fences included) are text, e.g. an example in the version notes
+		code := fence != ""
+		if marker := internal.ParseFenceLine(line); !code && marker != "" {
+			fence, code = marker, true
+		} else if code && marker != "" && strings.HasPrefix(marker, fence) {
+			fence = ""
+		}
+
+		// Anchor the pending directives to the line they precede
+		if pendingDirectives > 0 && strings.TrimSpace(line) != "" && (code || !internal.IsDirectiveLine(line)) {
+			for i := len(c.Directives) - pendingDirectives; i < len(c.Directives); i++ {
+				c.Directives[i].Target = validateachangelog.Position{Line: lineNumber, Column: internal.GetLineIndentation(line) + 1}
+			}
+			pendingDirectives = 0
+		}
+
+		if code {
+			appendText(c, currentVersion, currentSection, line)
+			inEntry = false
+
+			continue
+		}
+
+		// Parse entry continuation (wrapped line of the previous entry)
+		if inEntry && internal.IsContinuationLine(line) {
+			currentVersionEntries, _ := currentVersion.Entries.Get(currentSection)
+			lastEntry := validateachangelog.LastEntry(currentVersionEntries)
+			lastEntry.Description = internal.JoinContinuationLine(lastEntry.Description, line)
+
+			continue
+		}
+		inEntry = false
 
 		// Parse title
 		if internal.IsTitleLine(line) {
 			c.Title = internal.ParseTitleLine(line)
+			c.TitlePosition = validateachangelog.Position{Line: lineNumber, Column: 1}
 		}
 
 		// Parse version
 		if internal.IsVersionLine(line) {
 			// Push current version if there is one
 			if currentVersion.Version != "" {
+				trimNotes(currentVersion)
 				c.Versions = append(c.Versions, currentVersion)
 				currentVersion = &validateachangelog.Version{
-					Version:     "",
-					ReleaseDate: &time.Time{},
-					Entries:     *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+					Version:          "",
+					ReleaseDate:      &time.Time{},
+					Entries:          *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+					SectionPositions: map[string]validateachangelog.Position{},
+					SectionNotes:     map[string]string{},
 				}
 				currentSection = ""
 			}
@@ -55,6 +108,8 @@ func Parse(r io.Reader) (*validateachangelog.Changelog, error) {
 
 			currentVersion.Version = version
 			currentVersion.ReleaseDate = releaseDate
+			currentVersion.Yanked = internal.IsYankedVersionLine(line)
+			currentVersion.Position = validateachangelog.Position{Line: lineNumber, Column: 1}
 		}
 
 		// Parse section (Added, Changed, Removed, Fixed)
@@ -67,7 +122,10 @@ func Parse(r io.Reader) (*validateachangelog.Changelog, error) {
 
 			if !currentVersion.Entries.Has(currentSection) {
 				_ = currentVersion.Entries.Set(currentSection, []validateachangelog.Entry{})
+				currentVersion.SectionPositions[currentSection] = validateachangelog.Position{Line: lineNumber, Column: 1}
 			}
+
+			entryIndentations = nil
 		}
 
 		// Parse entry
@@ -78,17 +136,55 @@ func Parse(r io.Reader) (*validateachangelog.Changelog, error) {
 				return nil, fmt.Errorf("invalid changelog entry: %s (no section found)", line)
 			}
 
+			// Compute the entry depth using the indentation of the previous entries
+			indentation := internal.GetLineIndentation(line)
+			for len(entryIndentations) > 0 && entryIndentations[len(entryIndentations)-1] >= indentation {
+				entryIndentations = entryIndentations[:len(entryIndentations)-1]
+			}
+			depth := len(entryIndentations)
+			entryIndentations = append(entryIndentations, indentation)
+
 			// Todo: optimise?
 			currentVersionEntries, _ := currentVersion.Entries.Get(currentSection)
-			currentVersionEntries = append(currentVersionEntries, validateachangelog.Entry{
+			currentVersionEntries = validateachangelog.AppendEntry(currentVersionEntries, depth, validateachangelog.Entry{
 				Description: entry,
+				Position:    validateachangelog.Position{Line: lineNumber, Column: indentation + 1},
 			})
 			_ = currentVersion.Entries.Set(currentSection, currentVersionEntries)
+
+			inEntry = true
+		}
+
+		// Parse link reference definition (first definition wins)
+		if internal.IsLinkLine(line) {
+			label, url := internal.ParseLinkLine(line)
+
+			if !c.Links.Has(label) {
+				_ = c.Links.Set(label, url)
+				c.LinkPositions[label] = validateachangelog.Position{Line: lineNumber, Column: internal.GetLineIndentation(line) + 1}
+			}
+		}
+
+		// Parse validation directive (written back before its target, see writer)
+		if internal.IsDirectiveLine(line) {
+			action, rules := internal.ParseDirectiveLine(line)
+
+			c.Directives = append(c.Directives, validateachangelog.Directive{
+				Action:   action,
+				Rules:    rules,
+				Position: validateachangelog.Position{Line: lineNumber, Column: internal.GetLineIndentation(line) + 1},
+			})
+			pendingDirectives++
+		} else if internal.IsTextLine(line) {
+			appendText(c, currentVersion, currentSection, line)
 		}
 	}
 
+	c.Description = internal.TrimText(c.Description)
+
 	// Push the latest version (if any)
-	if currentSection != "" {
+	if currentVersion.Version != "" {
+		trimNotes(currentVersion)
 		c.Versions = append(c.Versions, currentVersion)
 	}
 
@@ -100,9 +196,39 @@ func Parse(r io.Reader) (*validateachangelog.Changelog, error) {
 		return nil, fmt.Errorf("no versions found in changelog")
 	}
 
+	// Resolve version links
+	for _, version := range c.Versions {
+		version.URL, _ = c.GetLink(version.Version)
+	}
+
 	return c, nil
 }
 
+// appendText appends the free-form text line to the changelog description, the version notes or the section notes
+func appendText(c *validateachangelog.Changelog, v *validateachangelog.Version, section, line string) {
+	switch {
+	case v.Version == "":
+		c.Description = internal.AppendTextLine(c.Description, line)
+	case section == "":
+		v.Notes = internal.AppendTextLine(v.Notes, line)
+	default:
+		v.SectionNotes[section] = internal.AppendTextLine(v.SectionNotes[section], line)
+	}
+}
+
+// trimNotes trims the notes of the version, empty section notes being removed
+func trimNotes(v *validateachangelog.Version) {
+	v.Notes = internal.TrimText(v.Notes)
+
+	for section, notes := range v.SectionNotes {
+		if notes = internal.TrimText(notes); notes != "" {
+			v.SectionNotes[section] = notes
+		} else {
+			delete(v.SectionNotes, section)
+		}
+	}
+}
+
 func ParseFile(filename string) (*validateachangelog.Changelog, error) {
 	f, err := os.Open(filename)
 	if err != nil {
@@ -1,9 +1,13 @@
 package parser
 
 import (
+	"encoding/json"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/vold-lu/validate-a-changelog"
 )
 
 func TestParseEmptyChangelog(t *testing.T) {
@@ -78,6 +82,24 @@ func TestParseValidChangelog(t *testing.T) {
 		t.Logf("Expected 2023-03-05 release date in c.Versions[1].ReleaseDate. Got: %s", c.Versions[1].ReleaseDate)
 		t.Fail()
 	}
+
+	// Validate link reference definitions
+	if c.Links.Len() != 15 {
+		t.Logf("Expected 15 links. Got: %d", c.Links.Len())
+		t.Fail()
+	}
+	if c.Versions[0].URL != "https://github.com/olivierlacan/keep-a-changelog/compare/v1.1.1...HEAD" {
+		t.Logf("Unexpected URL for c.Versions[0]. Got: %s", c.Versions[0].URL)
+		t.Fail()
+	}
+	if c.Versions[14].URL != "https://github.com/olivierlacan/keep-a-changelog/releases/tag/v0.0.1" {
+		t.Logf("Unexpected URL for c.Versions[14]. Got: %s", c.Versions[14].URL)
+		t.Fail()
+	}
+	if pos := c.LinkPositions["1.1.1"]; pos.Line == 0 {
+		t.Logf("Expected position for link 1.1.1")
+		t.Fail()
+	}
 }
 
 func TestParseValidChangelogMultipleSpaceBeforeEntry(t *testing.T) {
@@ -118,3 +140,248 @@ func TestParseInvalidChangelogMissingSection(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestParseValidChangelogPositions(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- First entry.\n  - Second entry.\n\n## [1.0.0] - 2025-10-28\n\n### Fixed\n\n- Third entry.\n")
+	c, err := Parse(r)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	if c.TitlePosition != (validateachangelog.Position{Line: 1, Column: 1}) {
+		t.Logf("Expected title at 1:1. Got: %v", c.TitlePosition)
+		t.Fail()
+	}
+
+	if c.Versions[0].Position.Line != 3 {
+		t.Logf("Expected Unreleased version at line 3. Got: %d", c.Versions[0].Position.Line)
+		t.Fail()
+	}
+	if c.Versions[1].Position.Line != 10 {
+		t.Logf("Expected 1.0.0 version at line 10. Got: %d", c.Versions[1].Position.Line)
+		t.Fail()
+	}
+
+	if pos := c.Versions[0].SectionPositions["Added"]; pos.Line != 5 {
+		t.Logf("Expected Added section at line 5. Got: %d", pos.Line)
+		t.Fail()
+	}
+
+	entries, _ := c.Versions[0].Entries.Get("Added")
+	if len(entries) != 1 || len(entries[0].Children) != 1 {
+		t.Fatalf("Expected 1 added entry with 1 child. Got: %v", entries)
+	}
+	if entries[0].Position != (validateachangelog.Position{Line: 7, Column: 1}) {
+		t.Logf("Expected first entry at 7:1. Got: %v", entries[0].Position)
+		t.Fail()
+	}
+	if entries[0].Children[0].Position != (validateachangelog.Position{Line: 8, Column: 3}) {
+		t.Logf("Expected second entry at 8:3. Got: %v", entries[0].Children[0].Position)
+		t.Fail()
+	}
+
+	entries, _ = c.Versions[1].Entries.Get("Fixed")
+	if len(entries) != 1 || entries[0].Position.Line != 14 {
+		t.Logf("Expected fixed entry at line 14. Got: %v", entries)
+		t.Fail()
+	}
+}
+
+func TestParseValidChangelogContinuationLines(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## [Unreleased]\n\n### Changed\n\n- Replace broken OpenGraph image with an appropriately-sized Keep a Changelog \n  image that will render properly\n- Fix OpenGraph title & description for all languages so the title and \ndescription when links are shared are language-appropriate\n- Single line entry.\n\nNot an entry.\n")
+	c, err := Parse(r)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	entries, _ := c.Versions[0].Entries.Get("Changed")
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 changed entries. Got: %d", len(entries))
+	}
+
+	expected := []string{
+		"Replace broken OpenGraph image with an appropriately-sized Keep a Changelog image that will render properly",
+		"Fix OpenGraph title & description for all languages so the title and description when links are shared are language-appropriate",
+		"Single line entry.",
+	}
+
+	for i, description := range expected {
+		if entries[i].Description != description {
+			t.Logf("Expected entry description \"%s\". Got: \"%s\"", description, entries[i].Description)
+			t.Fail()
+		}
+	}
+}
+
+func TestParseValidChangelogNestedEntries(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## [Unreleased]\n\n### Added\n\n- Translations:\n  - German\n  - French, with a\n    wrapped line\n    - Swiss French\n- Dark mode.\n\n### Fixed\n\n  - Indented entry.\n")
+	c, err := Parse(r)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	entries, _ := c.Versions[0].Entries.Get("Added")
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 added entries. Got: %d", len(entries))
+	}
+
+	if len(entries[0].Children) != 2 {
+		t.Fatalf("Expected 2 children. Got: %d", len(entries[0].Children))
+	}
+
+	french := entries[0].Children[1]
+	if french.Description != "French, with a wrapped line" {
+		t.Logf("Unexpected nested entry description: \"%s\"", french.Description)
+		t.Fail()
+	}
+	if len(french.Children) != 1 || french.Children[0].Description != "Swiss French" {
+		t.Logf("Expected Swiss French as nested entry of French. Got: %v", french.Children)
+		t.Fail()
+	}
+
+	if entries[1].Description != "Dark mode." || len(entries[1].Children) != 0 {
+		t.Logf("Unexpected entry: %v", entries[1])
+		t.Fail()
+	}
+
+	entries, _ = c.Versions[0].Entries.Get("Fixed")
+	if len(entries) != 1 || len(entries[0].Children) != 0 {
+		t.Logf("Expected 1 top level fixed entry. Got: %v", entries)
+		t.Fail()
+	}
+}
+
+func TestParseValidChangelogYankedVersion(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## [0.0.6] - 2014-12-14\n\n### Added\n\n- Test.\n\n## [0.0.5] - 2014-12-13 [YANKED]\n\n### Added\n\n- Test.\n")
+	c, err := Parse(r)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Versions) != 2 {
+		t.Fatalf("Expected 2 versions. Got: %d", len(c.Versions))
+	}
+
+	if c.Versions[0].Yanked {
+		t.Log("Expected c.Versions[0] not to be yanked")
+		t.Fail()
+	}
+
+	if !c.Versions[1].Yanked || c.Versions[1].Version != "0.0.5" || c.Versions[1].ReleaseDate == nil {
+		t.Logf("Expected c.Versions[1] to be yanked 0.0.5 with release date. Got: %v", c.Versions[1])
+		t.Fail()
+	}
+}
+
+func TestParseValidChangelogYankedUnreleasedVersion(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## [Unreleased] [YANKED]\n\n### Added\n\n- Test.\n\n## [0.0.5] - 2014-12-13\n\n### Added\n\n- Test.\n")
+	c, err := Parse(r)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Versions) != 2 || c.Versions[0].Version != "Unreleased" || !c.Versions[0].Yanked {
+		t.Fatalf("Expected yanked Unreleased version. Got: %v", c.Versions[0])
+	}
+}
+
+func TestParseValidChangelogDescriptionAndNotes(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\nAll notable changes to this project will be documented in this file.\n\nThe format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),\nand this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).\n\n## [2.0.0] - 2025-10-28\n\nThis release contains breaking changes.\n\n#### Upgrade notes\n\nRun the migration.\n\n### Removed\n\n- Legacy API.\n\nSee the migration guide.\n\n## [1.0.0] - 2025-10-27\n\nFirst stable release.\n\n[2.0.0]: https://example.org/compare/v1.0.0...v2.0.0\n")
+	c, err := Parse(r)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	expectedDescription := "All notable changes to this project will be documented in this file.\n\nThe format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),\nand this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html)."
+	if c.Description != expectedDescription {
+		t.Logf("Unexpected description: %q", c.Description)
+		t.Fail()
+	}
+
+	if len(c.Versions) != 2 {
+		t.Fatalf("Expected 2 versions. Got: %d", len(c.Versions))
+	}
+
+	expectedNotes := "This release contains breaking changes.\n\n#### Upgrade notes\n\nRun the migration."
+	if c.Versions[0].Notes != expectedNotes {
+		t.Logf("Unexpected c.Versions[0] notes: %q", c.Versions[0].Notes)
+		t.Fail()
+	}
+
+	// Text following the entries is kept with its section
+	if len(c.Versions[0].SectionNotes) != 1 || c.Versions[0].SectionNotes["Removed"] != "See the migration guide." {
+		t.Logf("Unexpected c.Versions[0] section notes: %q", c.Versions[0].SectionNotes)
+		t.Fail()
+	}
+
+	// Version without sections is kept
+	if c.Versions[1].Notes != "First stable release." {
+		t.Logf("Unexpected c.Versions[1] notes: %q", c.Versions[1].Notes)
+		t.Fail()
+	}
+}
+
+func TestParseValidChangelogFencedCodeBlocks(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\n## [1.0.0] - 2025-10-28\n\nUpgrade the configuration:\n\n```yaml\n- foo\n### bar\n```\n\n### Added\n\n- Feature.\n\n~~~\n## [0.1.0]\n\n- baz\n```\n~~~\n")
+	c, err := Parse(r)
+	if err != nil || c == nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Versions) != 1 {
+		t.Fatalf("Expected 1 version. Got: %d", len(c.Versions))
+	}
+
+	expectedNotes := "Upgrade the configuration:\n\n```yaml\n- foo\n### bar\n```"
+	if c.Versions[0].Notes != expectedNotes {
+		t.Logf("Unexpected c.Versions[0] notes: %q", c.Versions[0].Notes)
+		t.Fail()
+	}
+
+	if entries, _ := c.Versions[0].Entries.Get("Added"); c.Versions[0].Entries.Len() != 1 || len(entries) != 1 {
+		t.Logf("Unexpected c.Versions[0] entries: %v", c.Versions[0].Entries.Keys())
+		t.Fail()
+	}
+
+	// The code block is closed by its own fence only
+	if c.Versions[0].SectionNotes["Added"] != "~~~\n## [0.1.0]\n\n- baz\n```\n~~~" {
+		t.Logf("Unexpected c.Versions[0] section notes: %q", c.Versions[0].SectionNotes)
+		t.Fail()
+	}
+}
+
+func TestParseValidChangelogJSONRoundTrip(t *testing.T) {
+	r := strings.NewReader("# Changelog\n\nAll notable changes.\n\n## [Unreleased]\n\n### Fixed\n\n- Fix.\n\n### Added\n\n- Feature.\n  - Sub feature.\n\n## [1.0.0] - 2025-10-28 [YANKED]\n\nNotes.\n\n### Added\n\n- Initial release.\n\n[unreleased]: https://example.org/compare/v1.0.0...HEAD\n[1.0.0]: https://example.org/releases/tag/v1.0.0\n")
+	c, err := Parse(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded validateachangelog.Changelog
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(c.Versions[0].Entries.Keys(), decoded.Versions[0].Entries.Keys()) {
+		t.Fatalf("Expected sections order to be preserved. Got: %v", decoded.Versions[0].Entries.Keys())
+	}
+
+	if !reflect.DeepEqual(c.Links.Keys(), decoded.Links.Keys()) {
+		t.Fatalf("Expected links order to be preserved. Got: %v", decoded.Links.Keys())
+	}
+
+	// Re-encoding the decoded changelog must produce the same JSON
+	b2, err := json.Marshal(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != string(b2) {
+		t.Fatalf("Expected JSON round trip to be stable. Got:\n%s\n%s", string(b), string(b2))
+	}
+}
@@ -0,0 +1,232 @@
+package validateachangelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vold-lu/validate-a-changelog/internal"
+	"golang.org/x/mod/semver"
+)
+
+// UnreleasedVersion is the name of the version gathering the upcoming changes
+const UnreleasedVersion = "Unreleased"
+
+var compareURLRegex = regexp.MustCompile(`^(.*/compare/)([^/?#]+?)(\.\.\.?)([^/?#]+)$`)
+
+// Bump is a SemVer version increment
+type Bump int
+
+const (
+	BumpNone Bump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+func ParseBump(s string) (Bump, error) {
+	switch strings.ToLower(s) {
+	case "major":
+		return BumpMajor, nil
+	case "minor":
+		return BumpMinor, nil
+	case "patch":
+		return BumpPatch, nil
+	case "none", "":
+		return BumpNone, nil
+	}
+
+	return BumpNone, fmt.Errorf("invalid bump: %s (available values: major, minor, patch)", s)
+}
+
+func (b Bump) String() string {
+	switch b {
+	case BumpMajor:
+		return "major"
+	case BumpMinor:
+		return "minor"
+	case BumpPatch:
+		return "patch"
+	default:
+		return "none"
+	}
+}
+
+// NextVersion increments the version. A pre-release is promoted to its release when the bump allows it
+// (e.g. 2.0.0-rc.1 with a major bump gives 2.0.0).
+func NextVersion(version string, bump Bump) (string, error) {
+	v, ok := internal.ParseSemVer(version)
+	if !ok {
+		return "", fmt.Errorf("invalid version: %s", version)
+	}
+
+	isPreRelease := v.PreRelease != ""
+	v.PreRelease = ""
+	v.Build = ""
+
+	switch bump {
+	case BumpMajor:
+		if !isPreRelease || v.Minor != 0 || v.Patch != 0 {
+			v.Major++
+		}
+		v.Minor = 0
+		v.Patch = 0
+	case BumpMinor:
+		if !isPreRelease || v.Patch != 0 {
+			v.Minor++
+		}
+		v.Patch = 0
+	case BumpPatch:
+		if !isPreRelease {
+			v.Patch++
+		}
+	default:
+		return "", fmt.Errorf("invalid bump: %s", bump)
+	}
+
+	return v.String(), nil
+}
+
+// Unreleased returns the Unreleased version (nil if there is none)
+func (c *Changelog) Unreleased() *Version {
+	for _, v := range c.Versions {
+		if v.Version == UnreleasedVersion {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// LatestRelease returns the most recent released version (nil if there is none)
+func (c *Changelog) LatestRelease() *Version {
+	for _, v := range c.Versions {
+		if v.Version != UnreleasedVersion {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// Release promotes the Unreleased entries and notes to a new version released at the given date.
+// The Unreleased version is kept (empty) and the compare links are updated when possible.
+func (c *Changelog) Release(version string, releaseDate time.Time) error {
+	if !internal.IsValidSemVer(version) {
+		return fmt.Errorf("invalid version: %s", version)
+	}
+
+	unreleased := c.Unreleased()
+	if unreleased == nil {
+		return fmt.Errorf("no %s version found in changelog", UnreleasedVersion)
+	}
+
+	if unreleased.Entries.Len() == 0 && unreleased.Notes == "" {
+		return fmt.Errorf("nothing to release: %s version is empty", UnreleasedVersion)
+	}
+
+	for _, v := range c.Versions {
+		if v.Version == version {
+			return fmt.Errorf("version %s already exists in changelog", version)
+		}
+	}
+
+	latestRelease := c.LatestRelease()
+	if latestRelease != nil && semver.Compare("v"+version, "v"+latestRelease.Version) < 1 {
+		return fmt.Errorf("version %s must be greater than latest release %s", version, latestRelease.Version)
+	}
+
+	date := time.Date(releaseDate.Year(), releaseDate.Month(), releaseDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Move the unreleased content to the new version
+	released := &Version{
+		Version:          version,
+		ReleaseDate:      &date,
+		Notes:            unreleased.Notes,
+		Entries:          *internal.NewSortedMap(unreleased.Entries.Keys(), entriesValues(unreleased)),
+		SectionPositions: unreleased.SectionPositions,
+		SectionNotes:     unreleased.SectionNotes,
+	}
+
+	unreleased.Notes = ""
+	unreleased.Entries = *internal.NewEmptyMap[string, []Entry]()
+	unreleased.SectionPositions = map[string]Position{}
+	unreleased.SectionNotes = nil
+
+	// Register the new version right after the Unreleased one
+	versions := make([]*Version, 0, len(c.Versions)+1)
+	for _, v := range c.Versions {
+		versions = append(versions, v)
+
+		if v == unreleased {
+			versions = append(versions, released)
+		}
+	}
+	c.Versions = versions
+
+	c.updateReleaseLinks(unreleased, released)
+
+	return nil
+}
+
+// updateReleaseLinks updates the Unreleased compare link (`.../compare/v1.0.0...HEAD`) and creates the link of the
+// released version (`.../compare/v1.0.0...v1.1.0`)
+func (c *Changelog) updateReleaseLinks(unreleased, released *Version) {
+	label := ""
+	for _, key := range c.Links.Keys() {
+		if strings.EqualFold(key, UnreleasedVersion) {
+			label = key
+			break
+		}
+	}
+
+	if label == "" {
+		return
+	}
+
+	url, _ := c.Links.Get(label)
+
+	matches := compareURLRegex.FindStringSubmatch(url)
+	if len(matches) == 0 {
+		return
+	}
+
+	baseURL, baseRef, separator := matches[1], matches[2], matches[3]
+
+	// Derive the new tag from the previous one (e.g. v1.0.0 -> v1.1.0)
+	tagPrefix := "v"
+	for _, v := range c.Versions {
+		if v != unreleased && v != released && strings.HasSuffix(baseRef, v.Version) {
+			tagPrefix = strings.TrimSuffix(baseRef, v.Version)
+			break
+		}
+	}
+
+	releasedRef := tagPrefix + released.Version
+
+	_ = c.Links.Set(label, baseURL+releasedRef+separator+"HEAD")
+	unreleased.URL, _ = c.Links.Get(label)
+
+	// Register the released link right after the Unreleased one
+	index := 0
+	for i, key := range c.Links.Keys() {
+		if key == label {
+			index = i + 1
+			break
+		}
+	}
+
+	released.URL = baseURL + baseRef + separator + releasedRef
+	_ = c.Links.Insert(index, released.Version, released.URL)
+}
+
+func entriesValues(v *Version) map[string][]Entry {
+	values := make(map[string][]Entry, v.Entries.Len())
+
+	for _, key := range v.Entries.Keys() {
+		values[key], _ = v.Entries.Get(key)
+	}
+
+	return values
+}
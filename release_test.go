@@ -0,0 +1,156 @@
+package validateachangelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+func newReleasableChangelog() *Changelog {
+	releaseDate := time.Date(2025, 10, 28, 0, 0, 0, 0, time.UTC)
+
+	c := &Changelog{
+		Versions: []*Version{
+			{
+				Version: "Unreleased",
+				Notes:   "Upgrade notes.",
+				Entries: *internal.NewSortedMap([]string{"Added"}, map[string][]Entry{
+					"Added": {{Description: "Feature."}},
+				}),
+			},
+			{
+				Version:     "1.0.0",
+				ReleaseDate: &releaseDate,
+				Entries: *internal.NewSortedMap([]string{"Added"}, map[string][]Entry{
+					"Added": {{Description: "Initial release."}},
+				}),
+			},
+		},
+	}
+
+	_ = c.Links.Set("unreleased", "https://example.org/compare/v1.0.0...HEAD")
+	_ = c.Links.Set("1.0.0", "https://example.org/releases/tag/v1.0.0")
+
+	return c
+}
+
+func TestParseBump(t *testing.T) {
+	cases := []struct {
+		Value   string
+		Bump    Bump
+		IsValid bool
+	}{
+		{Value: "major", Bump: BumpMajor, IsValid: true},
+		{Value: "Minor", Bump: BumpMinor, IsValid: true},
+		{Value: "patch", Bump: BumpPatch, IsValid: true},
+		{Value: "", Bump: BumpNone, IsValid: true},
+		{Value: "huge", Bump: BumpNone, IsValid: false},
+	}
+
+	for _, c := range cases {
+		bump, err := ParseBump(c.Value)
+		if (err == nil) != c.IsValid || bump != c.Bump {
+			t.Logf("ParseBump(%s). Got (%v, %v), wanted %v", c.Value, bump, err, c.Bump)
+			t.Fail()
+		}
+	}
+}
+
+func TestNextVersion(t *testing.T) {
+	cases := []struct {
+		Version string
+		Bump    Bump
+		Next    string
+	}{
+		{Version: "1.2.3", Bump: BumpMajor, Next: "2.0.0"},
+		{Version: "1.2.3", Bump: BumpMinor, Next: "1.3.0"},
+		{Version: "1.2.3", Bump: BumpPatch, Next: "1.2.4"},
+		{Version: "1.2.3+build.1", Bump: BumpPatch, Next: "1.2.4"},
+		{Version: "2.0.0-rc.1", Bump: BumpMajor, Next: "2.0.0"},
+		{Version: "2.1.0-rc.1", Bump: BumpMajor, Next: "3.0.0"},
+		{Version: "2.1.0-rc.1", Bump: BumpMinor, Next: "2.1.0"},
+		{Version: "2.1.1-rc.1", Bump: BumpPatch, Next: "2.1.1"},
+	}
+
+	for _, c := range cases {
+		next, err := NextVersion(c.Version, c.Bump)
+		if err != nil || next != c.Next {
+			t.Logf("NextVersion(%s, %s). Got (%s, %v), wanted %s", c.Version, c.Bump, next, err, c.Next)
+			t.Fail()
+		}
+	}
+
+	if _, err := NextVersion("1.0", BumpMajor); err == nil {
+		t.Fatal("NextVersion() should fail on invalid version")
+	}
+	if _, err := NextVersion("1.0.0", BumpNone); err == nil {
+		t.Fatal("NextVersion() should fail on invalid bump")
+	}
+}
+
+func TestChangelog_Release(t *testing.T) {
+	c := newReleasableChangelog()
+
+	if err := c.Release("1.1.0", time.Date(2025, 11, 1, 15, 30, 0, 0, time.Local)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Versions) != 3 {
+		t.Fatalf("Expected 3 versions. Got: %d", len(c.Versions))
+	}
+
+	unreleased, released := c.Versions[0], c.Versions[1]
+
+	if unreleased.Version != "Unreleased" || unreleased.Entries.Len() != 0 || unreleased.Notes != "" {
+		t.Fatalf("Expected empty Unreleased version. Got: %v", unreleased)
+	}
+
+	if released.Version != "1.1.0" || released.ReleaseDate == nil || released.ReleaseDate.Format("2006-01-02") != "2025-11-01" {
+		t.Fatalf("Expected 1.1.0 released on 2025-11-01. Got: %v", released)
+	}
+
+	if entries, _ := released.Entries.Get("Added"); len(entries) != 1 || released.Notes != "Upgrade notes." {
+		t.Fatalf("Expected Unreleased content to be moved. Got: %v", released)
+	}
+
+	if url, _ := c.Links.Get("unreleased"); url != "https://example.org/compare/v1.1.0...HEAD" {
+		t.Fatalf("Unexpected Unreleased link: %s", url)
+	}
+
+	if url, _ := c.Links.Get("1.1.0"); url != "https://example.org/compare/v1.0.0...v1.1.0" || released.URL != url {
+		t.Fatalf("Unexpected 1.1.0 link: %s", url)
+	}
+
+	if keys := c.Links.Keys(); keys[0] != "unreleased" || keys[1] != "1.1.0" || keys[2] != "1.0.0" {
+		t.Fatalf("Unexpected links order: %v", keys)
+	}
+}
+
+func TestChangelog_ReleaseInvalid(t *testing.T) {
+	releaseDate := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := newReleasableChangelog().Release("1.1", releaseDate); err == nil {
+		t.Fatal("Release() should fail on invalid version")
+	}
+	if err := newReleasableChangelog().Release("1.0.0", releaseDate); err == nil {
+		t.Fatal("Release() should fail on existing version")
+	}
+	if err := newReleasableChangelog().Release("0.9.0", releaseDate); err == nil {
+		t.Fatal("Release() should fail on older version")
+	}
+
+	c := newReleasableChangelog()
+	if err := c.Release("1.1.0", releaseDate); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Release("1.2.0", releaseDate); err == nil {
+		t.Fatal("Release() should fail on empty Unreleased version")
+	}
+
+	c = newReleasableChangelog()
+	c.Versions = c.Versions[1:]
+	if err := c.Release("1.1.0", releaseDate); err == nil {
+		t.Fatal("Release() should fail without Unreleased version")
+	}
+}
@@ -1,23 +1,98 @@
 package validateachangelog
 
 import (
+	"strings"
 	"time"
 
 	"github.com/vold-lu/validate-a-changelog/internal"
 )
 
 type Changelog struct {
-	Title    string     `json:"title"`
-	Versions []*Version `json:"versions"`
+	Title         string   `json:"title"`
+	TitlePosition Position `json:"title_position"`
+	// Description contains the raw Markdown text preceding the first version (e.g. "All notable changes...")
+	Description string     `json:"description"`
+	Versions    []*Version `json:"versions"`
+
+	// Links contains the link reference definitions (`[label]: url`), indexed by label
+	Links internal.SortedMap[string, string] `json:"links"`
+	// LinkPositions contains the position of each link reference definition, indexed by label
+	LinkPositions map[string]Position `json:"link_positions"`
+
+	// Directives contains the validation directive comments (`<!-- changelog-disable ... -->`), in order
+	Directives []Directive `json:"directives,omitempty"`
 }
 
 type Version struct {
 	Version     string     `json:"version"`
 	ReleaseDate *time.Time `json:"release_date"`
+	Position    Position   `json:"position"`
+	// URL is the link reference definition matching the version (if any)
+	URL string `json:"url,omitempty"`
+	// Yanked is true if the version has been pulled (`## [0.0.5] - 2014-12-13 [YANKED]`)
+	Yanked bool `json:"yanked"`
+	// Notes contains the raw Markdown text of the version preceding its first section (e.g. upgrade notes)
+	Notes string `json:"notes,omitempty"`
+	// SectionNotes contains the raw Markdown text following the entries of a section (e.g. a migration guide link),
+	// indexed by section name
+	SectionNotes map[string]string `json:"section_notes,omitempty"`
 
 	Entries internal.SortedMap[string, []Entry] `json:"entries"`
+	// SectionPositions contains the position of each section heading, indexed by section name
+	SectionPositions map[string]Position `json:"section_positions"`
 }
 
 type Entry struct {
-	Description string `json:"description"`
+	Description string   `json:"description"`
+	Position    Position `json:"position"`
+	// Children contains the nested entries (sub-bullets) of the entry
+	Children []Entry `json:"children,omitempty"`
+}
+
+// GetLink returns the URL of the link reference definition matching the label (case-insensitive)
+func (c *Changelog) GetLink(label string) (string, bool) {
+	if url, exists := c.Links.Get(label); exists {
+		return url, true
+	}
+
+	for _, key := range c.Links.Keys() {
+		if strings.EqualFold(key, label) {
+			return c.Links.Get(key)
+		}
+	}
+
+	return "", false
+}
+
+const (
+	// DirectiveDisable disables the rules until the next DirectiveEnable
+	DirectiveDisable = "disable"
+	// DirectiveEnable enables back the rules
+	DirectiveEnable = "enable"
+	// DirectiveDisableNextLine disables the rules for the line following the directive (see Directive.Target)
+	DirectiveDisableNextLine = "disable-next-line"
+)
+
+// Directive is a comment controlling the validation of the following lines
+// (e.g. `<!-- changelog-disable-next-line unsorted-change-type -->`)
+type Directive struct {
+	// Action is one of DirectiveDisable, DirectiveEnable or DirectiveDisableNextLine
+	Action string `json:"action"`
+	// Rules contains the IDs or names of the rules (all rules when empty)
+	Rules    []string `json:"rules,omitempty"`
+	Position Position `json:"position"`
+	// Target is the position of the line the directive precedes, blank lines and directives aside (zero at the end of
+	// the changelog)
+	Target Position `json:"target"`
+}
+
+// Position locates a node in the changelog source (1-based, zero value means unknown)
+type Position struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// IsValid returns true if the position points somewhere in the changelog source
+func (p Position) IsValid() bool {
+	return p.Line > 0
 }
@@ -0,0 +1,21 @@
+package validateachangelog
+
+import "testing"
+
+func TestChangelog_GetLink(t *testing.T) {
+	c := &Changelog{}
+	_ = c.Links.Set("unreleased", "https://example.org/compare/v1.0.0...HEAD")
+	_ = c.Links.Set("1.0.0", "https://example.org/releases/tag/v1.0.0")
+
+	if url, exists := c.GetLink("Unreleased"); !exists || url != "https://example.org/compare/v1.0.0...HEAD" {
+		t.Fatalf("Expected case-insensitive match for Unreleased. Got: %s", url)
+	}
+
+	if url, exists := c.GetLink("1.0.0"); !exists || url != "https://example.org/releases/tag/v1.0.0" {
+		t.Fatalf("Expected match for 1.0.0. Got: %s", url)
+	}
+
+	if _, exists := c.GetLink("0.9.0"); exists {
+		t.Fatal("Expected no match for 0.9.0")
+	}
+}
@@ -0,0 +1,33 @@
+package validateachangelog
+
+import "strings"
+
+// DefaultTagPattern is the default git tag naming of the versions, {version} being replaced by the version
+const DefaultTagPattern = "v{version}"
+
+// TagName returns the tag of the version following the pattern (DefaultTagPattern when empty)
+func TagName(pattern, version string) string {
+	if pattern == "" {
+		pattern = DefaultTagPattern
+	}
+
+	return strings.ReplaceAll(pattern, "{version}", version)
+}
+
+// TagVersion returns the version of the tag following the pattern (DefaultTagPattern when empty)
+func TagVersion(pattern, tag string) (string, bool) {
+	if pattern == "" {
+		pattern = DefaultTagPattern
+	}
+
+	prefix, suffix, found := strings.Cut(pattern, "{version}")
+	if !found {
+		return "", false
+	}
+
+	if len(tag) <= len(prefix)+len(suffix) || !strings.HasPrefix(tag, prefix) || !strings.HasSuffix(tag, suffix) {
+		return "", false
+	}
+
+	return tag[len(prefix) : len(tag)-len(suffix)], true
+}
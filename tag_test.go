@@ -0,0 +1,37 @@
+package validateachangelog
+
+import "testing"
+
+func TestTagName(t *testing.T) {
+	if tag := TagName("", "1.2.0"); tag != "v1.2.0" {
+		t.Fatalf("Unexpected tag: %s", tag)
+	}
+
+	if tag := TagName("release-{version}", "1.2.0"); tag != "release-1.2.0" {
+		t.Fatalf("Unexpected tag: %s", tag)
+	}
+}
+
+func TestTagVersion(t *testing.T) {
+	cases := []struct {
+		Pattern string
+		Tag     string
+		Version string
+		IsValid bool
+	}{
+		{Pattern: "", Tag: "v1.2.0", Version: "1.2.0", IsValid: true},
+		{Pattern: "{version}", Tag: "1.2.0", Version: "1.2.0", IsValid: true},
+		{Pattern: "app/v{version}", Tag: "app/v2.0.0-rc.1", Version: "2.0.0-rc.1", IsValid: true},
+		{Pattern: "", Tag: "1.2.0", IsValid: false},
+		{Pattern: "", Tag: "v", IsValid: false},
+		{Pattern: "latest", Tag: "latest", IsValid: false},
+	}
+
+	for _, c := range cases {
+		version, ok := TagVersion(c.Pattern, c.Tag)
+		if ok != c.IsValid || version != c.Version {
+			t.Logf("TagVersion(%s, %s). Got (%s, %v), wanted %s", c.Pattern, c.Tag, version, ok, c.Version)
+			t.Fail()
+		}
+	}
+}
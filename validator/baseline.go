@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+)
+
+// Baseline contains the accepted issues, fingerprinted by rule, version and section (not by position, so that they
+// survive unrelated edits). Issues exceeding the accepted count of their fingerprint are still reported.
+type Baseline struct {
+	Issues []BaselineIssue `json:"issues"`
+}
+
+type BaselineIssue struct {
+	Rule    string `json:"rule"`
+	Version string `json:"version,omitempty"`
+	Section string `json:"section,omitempty"`
+	// Count is the number of accepted issues with this fingerprint
+	Count int `json:"count"`
+}
+
+type fingerprint struct {
+	Rule, Version, Section string
+}
+
+// NewBaseline returns the baseline accepting the issues
+func NewBaseline(issues []ValidationIssue) *Baseline {
+	counts := map[fingerprint]int{}
+	for _, issue := range issues {
+		counts[fingerprint{Rule: issue.Rule, Version: issue.Version, Section: issue.Section}]++
+	}
+
+	b := &Baseline{Issues: []BaselineIssue{}}
+	for f, count := range counts {
+		b.Issues = append(b.Issues, BaselineIssue{Rule: f.Rule, Version: f.Version, Section: f.Section, Count: count})
+	}
+
+	sort.Slice(b.Issues, func(i, j int) bool {
+		a, b := b.Issues[i], b.Issues[j]
+
+		switch {
+		case a.Rule != b.Rule:
+			return a.Rule < b.Rule
+		case a.Version != b.Version:
+			return a.Version < b.Version
+		default:
+			return a.Section < b.Section
+		}
+	})
+
+	return b
+}
+
+// ReadBaseline reads the baseline (JSON)
+func ReadBaseline(r io.Reader) (*Baseline, error) {
+	b := &Baseline{}
+	if err := json.NewDecoder(r).Decode(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// LoadBaseline loads the baseline file
+func LoadBaseline(filename string) (*Baseline, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return ReadBaseline(f)
+}
+
+// Write writes the baseline (indented JSON)
+func (b *Baseline) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(b)
+}
+
+// Save writes the baseline file
+func (b *Baseline) Save(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	if err := b.Write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Filter returns the issues not accepted by the baseline
+func (b *Baseline) Filter(issues []ValidationIssue) []ValidationIssue {
+	remaining := map[fingerprint]int{}
+	for _, issue := range b.Issues {
+		remaining[fingerprint{Rule: issue.Rule, Version: issue.Version, Section: issue.Section}] += issue.Count
+	}
+
+	var kept []ValidationIssue
+	for _, issue := range issues {
+		f := fingerprint{Rule: issue.Rule, Version: issue.Version, Section: issue.Section}
+
+		if remaining[f] > 0 {
+			remaining[f]--
+			continue
+		}
+
+		kept = append(kept, issue)
+	}
+
+	return kept
+}
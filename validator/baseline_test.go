@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/parser"
+)
+
+func TestBaseline(t *testing.T) {
+	accepted := []ValidationIssue{
+		{Rule: "CL004", Version: "1.0.0", Section: "Added", Line: 9},
+		{Rule: "CL007", Version: "1.0.0", Section: "Fixed", Line: 12},
+		{Rule: "CL007", Version: "1.0.0", Section: "Fixed", Line: 13},
+	}
+
+	baseline := NewBaseline(accepted)
+
+	expected := []BaselineIssue{
+		{Rule: "CL004", Version: "1.0.0", Section: "Added", Count: 1},
+		{Rule: "CL007", Version: "1.0.0", Section: "Fixed", Count: 2},
+	}
+	if !reflect.DeepEqual(baseline.Issues, expected) {
+		t.Fatalf("Unexpected baseline: %v", baseline.Issues)
+	}
+
+	// The lines changed (entries added above) and a third empty entry was added
+	issues := baseline.Filter([]ValidationIssue{
+		{Rule: "CL004", Version: "1.0.0", Section: "Added", Line: 19},
+		{Rule: "CL007", Version: "1.0.0", Section: "Fixed", Line: 22},
+		{Rule: "CL007", Version: "1.0.0", Section: "Fixed", Line: 23},
+		{Rule: "CL007", Version: "1.0.0", Section: "Fixed", Line: 24},
+		{Rule: "CL004", Version: "1.1.0", Section: "Added", Line: 3},
+	})
+
+	if len(issues) != 2 || issues[0].Line != 24 || issues[1].Version != "1.1.0" {
+		t.Fatalf("Unexpected issues: %v", issues)
+	}
+}
+
+func TestBaselineReadWrite(t *testing.T) {
+	baseline := NewBaseline([]ValidationIssue{{Rule: "CL002", Version: "0.1.0"}})
+
+	var buf bytes.Buffer
+	if err := baseline.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := ReadBaseline(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(read, baseline) {
+		t.Fatalf("Expected %v. Got: %v", baseline, read)
+	}
+
+	filename := filepath.Join(t.TempDir(), "baseline.json")
+	if err := baseline.Save(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadBaseline(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(loaded, baseline) {
+		t.Fatalf("Expected %v. Got: %v", baseline, loaded)
+	}
+}
+
+func TestValidateChangelogBaseline(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader(unsortedChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Validate(c, nil)
+	if err == nil {
+		t.Fatal()
+	}
+
+	baseline := NewBaseline(err.(*ValidationError).Issues)
+
+	c.Versions = append([]*validateachangelog.Version{{Version: "1.1.0"}}, c.Versions...)
+
+	err = Validate(c, &Options{Baseline: baseline, AllowEmptyVersion: true})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Rule != "CL002" || issues[0].Version != "1.1.0" {
+		t.Fatalf("Unexpected issues: %v", issues)
+	}
+}
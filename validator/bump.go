@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+// checkSemVerBump makes sure each released version bump is big enough for its entries (e.g. a patch release cannot
+// contain Added entries)
+func checkSemVerBump(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	for i, version := range c.Versions {
+		current, ok := internal.ParseSemVer(version.Version)
+		if !ok || current.PreRelease != "" {
+			continue
+		}
+
+		// Find the previous release (pre-releases are skipped)
+		var previousVersion *validateachangelog.Version
+		for _, v := range c.Versions[i+1:] {
+			if previous, ok := internal.ParseSemVer(v.Version); ok && previous.PreRelease == "" {
+				previousVersion = v
+				break
+			}
+		}
+
+		if previousVersion == nil {
+			continue
+		}
+
+		actual, e := validateachangelog.BumpBetween(previousVersion.Version, version.Version)
+		if e != nil {
+			continue
+		}
+
+		required := validateachangelog.EffectiveBump(previousVersion.Version, version.RequiredBump(opts.BumpRules))
+
+		if actual < required {
+			r.Report(version.Position, version.Version, "", fmt.Sprintf("%s bump from %s but entries require a %s bump", actual, previousVersion.Version, required))
+		}
+	}
+}
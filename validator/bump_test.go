@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+)
+
+func TestValidateChangelogSemVerBump(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Versions []*validateachangelog.Version
+		Issues   int
+	}{
+		{
+			Name: "valid bumps",
+			Versions: []*validateachangelog.Version{
+				newVersion("Unreleased", "", "Removed"),
+				newVersion("2.0.0", "", "Removed"),
+				newVersion("1.5.0", "", "Added", "Fixed"),
+				newVersion("1.4.1", "", "Fixed"),
+				newVersion("1.4.0", "", "Added"),
+			},
+		},
+		{
+			Name: "patch with added and removed entries",
+			Versions: []*validateachangelog.Version{
+				newVersion("1.4.1", "", "Added", "Removed"),
+				newVersion("1.4.0", "", "Added"),
+			},
+			Issues: 1,
+		},
+		{
+			Name: "minor with removed entries",
+			Versions: []*validateachangelog.Version{
+				newVersion("1.5.0", "", "Removed"),
+				newVersion("1.4.0", "", "Added"),
+			},
+			Issues: 1,
+		},
+		{
+			Name: "minor with removed entries while major version is 0",
+			Versions: []*validateachangelog.Version{
+				newVersion("0.5.0", "", "Removed"),
+				newVersion("0.4.0", "", "Added"),
+			},
+		},
+		{
+			Name: "pre-releases are skipped",
+			Versions: []*validateachangelog.Version{
+				newVersion("1.4.1", "", "Fixed"),
+				newVersion("1.4.1-rc.1", "", "Added"),
+				newVersion("1.4.0", "", "Added"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			err := Validate(&validateachangelog.Changelog{Versions: c.Versions}, &Options{
+				AllowMissingReleaseDate: true,
+				CheckSemVerBump:         true,
+			})
+
+			issues := 0
+			if err != nil {
+				issues = len(err.(*ValidationError).Issues)
+			}
+
+			if issues != c.Issues {
+				t.Logf("Got %d issues, wanted %d (%v)", issues, c.Issues, err)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestValidateChangelogSemVerBumpRules(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("1.4.1", "", "Security"),
+			newVersion("1.4.0", "", "Added"),
+		},
+	}
+
+	rules := validateachangelog.DefaultBumpRules()
+	rules["Security"] = validateachangelog.BumpMinor
+
+	if err := Validate(c, &Options{AllowMissingReleaseDate: true, CheckSemVerBump: true, BumpRules: rules}); err == nil {
+		t.Fatal("Validate() should have failed with custom bump rules")
+	}
+}
@@ -0,0 +1,196 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+)
+
+// checkReleasedVersionEdit makes sure the versions released in the base changelog are neither modified nor removed
+func checkReleasedVersionEdit(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	// The rule may be enabled (Options.Rules) without base
+	if opts.Base == nil {
+		return
+	}
+
+	for _, baseVersion := range opts.Base.Versions {
+		if baseVersion.Version == unreleasedVersion {
+			continue
+		}
+
+		version := findVersion(c, baseVersion.Version)
+		if version == nil {
+			r.Report(c.TitlePosition, baseVersion.Version, "", "released version removed since the base revision")
+			continue
+		}
+
+		if !versionsEqual(baseVersion, version) {
+			r.Report(version.Position, version.Version, "", "released version modified since the base revision")
+		}
+	}
+}
+
+// filterUnchanged removes the issues of the versions, sections and entries unchanged since the base changelog (issues
+// without version are kept)
+func filterUnchanged(base, c *validateachangelog.Changelog, issues []ValidationIssue) []ValidationIssue {
+	var kept []ValidationIssue
+
+	for _, issue := range issues {
+		if issue.Version == "" || isChanged(base, c, issue) {
+			kept = append(kept, issue)
+		}
+	}
+
+	return kept
+}
+
+// isChanged returns true if the version, section or entry of the issue was added, modified or removed since the base
+// changelog. For the rules reporting an issue on the neighbour of the culprit (unsorted-version and compare-link), a
+// version whose neighbours changed is changed too.
+func isChanged(base, c *validateachangelog.Changelog, issue ValidationIssue) bool {
+	baseVersion, currentVersion := findVersion(base, issue.Version), findVersion(c, issue.Version)
+	if baseVersion == nil || currentVersion == nil {
+		return true
+	}
+
+	if issue.Section == "" {
+		if (issue.Rule == "CL006" || issue.Rule == "CL012") && neighbours(base, issue.Version) != neighbours(c, issue.Version) {
+			return true
+		}
+
+		return !versionsEqual(baseVersion, currentVersion)
+	}
+
+	// Entry-level issues (e.g. empty-entry) are compared with the entry at the same place in the base section
+	currentEntries, _ := currentVersion.Entries.Get(issue.Section)
+	if path := entryPath(currentEntries, issue.Line); issue.Line > 0 && path != nil {
+		baseEntries, _ := baseVersion.Entries.Get(issue.Section)
+
+		entry, baseEntry := entryAt(currentEntries, path), entryAt(baseEntries, path)
+		return baseEntry == nil || baseEntry.Description != entry.Description || !entriesEqual(baseEntry.Children, entry.Children)
+	}
+
+	return !sectionsEqual(baseVersion, currentVersion, issue.Section)
+}
+
+// versionsEqual returns true if the versions have the same content (positions are ignored)
+func versionsEqual(a, b *validateachangelog.Version) bool {
+	if a.Version != b.Version || a.Yanked != b.Yanked || a.Notes != b.Notes || a.Entries.Len() != b.Entries.Len() {
+		return false
+	}
+
+	if (a.ReleaseDate == nil) != (b.ReleaseDate == nil) || (a.ReleaseDate != nil && !a.ReleaseDate.Equal(*b.ReleaseDate)) {
+		return false
+	}
+
+	for _, section := range a.Entries.Keys() {
+		if !sectionsEqual(a, b, section) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sectionsEqual returns true if the section has the same entries, notes and rank in both versions
+func sectionsEqual(a, b *validateachangelog.Version, section string) bool {
+	aEntries, aExists := a.Entries.Get(section)
+	bEntries, bExists := b.Entries.Get(section)
+
+	if !aExists || !bExists || sectionIndex(a, section) != sectionIndex(b, section) {
+		return false
+	}
+
+	if a.SectionNotes[section] != b.SectionNotes[section] {
+		return false
+	}
+
+	return entriesEqual(aEntries, bEntries)
+}
+
+func entriesEqual(a, b []validateachangelog.Entry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Description != b[i].Description || !entriesEqual(a[i].Children, b[i].Children) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// entryPath returns the indexes leading to the entry of the section starting at the line (nil if there is none)
+func entryPath(entries []validateachangelog.Entry, line int) []int {
+	for i, entry := range entries {
+		if entry.Position.Line == line {
+			return []int{i}
+		}
+
+		if path := entryPath(entry.Children, line); path != nil {
+			return append([]int{i}, path...)
+		}
+	}
+
+	return nil
+}
+
+// entryAt returns the entry at the given indexes (nil if there is none)
+func entryAt(entries []validateachangelog.Entry, path []int) *validateachangelog.Entry {
+	var entry *validateachangelog.Entry
+
+	for _, i := range path {
+		if i >= len(entries) {
+			return nil
+		}
+
+		entry = &entries[i]
+		entries = entry.Children
+	}
+
+	return entry
+}
+
+func sectionIndex(v *validateachangelog.Version, section string) int {
+	for i, key := range v.Entries.Keys() {
+		if key == section {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// neighbours returns the versions preceding and following the version in the changelog (empty if there is none)
+func neighbours(c *validateachangelog.Changelog, version string) [2]string {
+	var n [2]string
+
+	for i, v := range c.Versions {
+		if !strings.EqualFold(v.Version, version) {
+			continue
+		}
+
+		if i > 0 {
+			n[0] = strings.ToLower(c.Versions[i-1].Version)
+		}
+		if i < len(c.Versions)-1 {
+			n[1] = strings.ToLower(c.Versions[i+1].Version)
+		}
+		break
+	}
+
+	return n
+}
+
+// findVersion returns the version of the changelog (nil if there is none)
+func findVersion(c *validateachangelog.Changelog, version string) *validateachangelog.Version {
+	for _, v := range c.Versions {
+		if strings.EqualFold(v.Version, version) {
+			return v
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog/parser"
+)
+
+const baseChangelog = `# Changelog
+
+## [Unreleased]
+
+### Added
+
+- Add the export
+
+## [1.1.0] - 2024-02-01
+
+### Fixed
+
+- Fix the crash
+
+### Added
+
+- Add the import
+
+## [1.0.0] - 2024-01-01
+
+### Added
+
+- Initial release
+`
+
+const headChangelog = `# Changelog
+
+## [Unreleased]
+
+### Added
+
+- Add the export
+- Add the CSV export
+
+### Fixed
+
+- 
+
+## [1.1.0] - 2024-02-01
+
+### Fixed
+
+- Fix the crash
+
+### Added
+
+- Add the import
+
+## [1.0.0] - 2024-01-01
+
+### Added
+
+- Initial release (with a typo fix)
+
+## [0.1.0]
+`
+
+func TestValidateChangelogBase(t *testing.T) {
+	base, err := parser.Parse(strings.NewReader(baseChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := parser.Parse(strings.NewReader(headChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		Name   string
+		Base   bool
+		Issues string
+	}{
+		{Name: "full", Base: false, Issues: "CL007@Unreleased,CL004@1.1.0,CL002@0.1.0,CL005@0.1.0"},
+		// The unsorted 1.1.0 sections are left untouched, the modified 1.0.0 is reported as an edit
+		{Name: "base", Base: true, Issues: "CL007@Unreleased,CL019@1.0.0,CL002@0.1.0,CL005@0.1.0"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			opts := &Options{}
+			if tc.Base {
+				opts.Base = base
+			}
+
+			err := Validate(c, opts)
+			if err == nil {
+				t.Fatal()
+			}
+
+			var issues []string
+			for _, issue := range err.(*ValidationError).Issues {
+				issues = append(issues, issue.Rule+"@"+issue.Version)
+			}
+
+			if strings.Join(issues, ",") != tc.Issues {
+				t.Fatalf("Expected issues %s. Got: %v", tc.Issues, issues)
+			}
+		})
+	}
+}
+
+func TestValidateChangelogRemovedRelease(t *testing.T) {
+	base, err := parser.Parse(strings.NewReader(baseChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := parser.Parse(strings.NewReader(baseChangelog[:strings.Index(baseChangelog, "## [1.0.0]")]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Validate(c, &Options{Base: base, Rules: map[string]Severity{"unsorted-change-type": SeverityOff}})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Rule != "CL019" || issues[0].Version != "1.0.0" || !strings.Contains(issues[0].Error, "removed") {
+		t.Fatalf("Unexpected issues: %v", issues)
+	}
+}
+
+func TestValidateChangelogAddedUnsortedRelease(t *testing.T) {
+	base, err := parser.Parse(strings.NewReader(baseChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1.0.5 is added above the unchanged 1.1.0 (which is reported as the unsorted version)
+	c, err := parser.Parse(strings.NewReader(strings.Replace(baseChangelog, "## [1.1.0]", "## [1.0.5] - 2024-03-01\n\n### Fixed\n\n- Fix the export\n\n## [1.1.0]", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Validate(c, &Options{Base: base})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Rule != "CL006" || issues[0].Version != "1.1.0" {
+		t.Fatalf("Unexpected issues: %v", issues)
+	}
+}
+
+func TestValidateChangelogReleasedUnreleased(t *testing.T) {
+	const undated = "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- Add the export\n\n## [1.0.0]\n\n### Added\n\n- Initial release\n"
+
+	base, err := parser.Parse(strings.NewReader(undated))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The undated 1.0.0 gets a new neighbour but its missing-release-date issue is not new
+	c, err := parser.Parse(strings.NewReader(strings.Replace(undated, "## [Unreleased]", "## [1.0.1] - 2024-03-01", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Validate(c, &Options{Base: base}); err != nil {
+		t.Fatalf("Unexpected issues: %v", err)
+	}
+}
+
+func TestValidateChangelogAddedEntry(t *testing.T) {
+	const emptyEntry = "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- \n"
+
+	base, err := parser.Parse(strings.NewReader(emptyEntry))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the appended empty entry is reported, the first one already exists in the base section
+	c, err := parser.Parse(strings.NewReader(emptyEntry + "- New thing\n- \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Validate(c, &Options{Base: base})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Rule != "CL007" || issues[0].Line != 9 {
+		t.Fatalf("Unexpected issues: %v", issues)
+	}
+}
@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+)
+
+// checkInvalidDirective makes sure the directive comments designate existing rules
+func checkInvalidDirective(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	for _, directive := range c.Directives {
+		for _, name := range directive.Rules {
+			if _, exists := opts.ruleID(name); !exists {
+				r.Report(directive.Position, "", "", fmt.Sprintf("unknown rule `%s` in changelog-%s directive", name, directive.Action))
+			}
+		}
+	}
+}
+
+// suppressIssues removes the issues disabled by the directive comments of the changelog (issues without position
+// cannot be suppressed)
+func suppressIssues(c *validateachangelog.Changelog, opts *Options, issues []ValidationIssue) []ValidationIssue {
+	if len(c.Directives) == 0 {
+		return issues
+	}
+
+	var kept []ValidationIssue
+	for _, issue := range issues {
+		if !isSuppressed(c.Directives, opts, issue) {
+			kept = append(kept, issue)
+		}
+	}
+
+	return kept
+}
+
+// isSuppressed returns true if the issue is disabled by the directives preceding it
+func isSuppressed(directives []validateachangelog.Directive, opts *Options, issue ValidationIssue) bool {
+	if issue.Line == 0 {
+		return false
+	}
+
+	suppressed := false
+
+	for _, directive := range directives {
+		if directive.Position.Line >= issue.Line {
+			break
+		}
+
+		if !directiveMatches(directive, opts, issue.Rule) {
+			continue
+		}
+
+		switch directive.Action {
+		case validateachangelog.DirectiveDisableNextLine:
+			if directive.Target.Line == issue.Line {
+				return true
+			}
+		case validateachangelog.DirectiveDisable:
+			suppressed = true
+		case validateachangelog.DirectiveEnable:
+			suppressed = false
+		}
+	}
+
+	return suppressed
+}
+
+// directiveMatches returns true if the directive applies to the rule
+func directiveMatches(directive validateachangelog.Directive, opts *Options, ruleID string) bool {
+	if len(directive.Rules) == 0 {
+		return true
+	}
+
+	for _, name := range directive.Rules {
+		if id, exists := opts.ruleID(name); exists && strings.EqualFold(id, ruleID) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+package validator
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/parser"
+	"github.com/vold-lu/validate-a-changelog/writer"
+)
+
+const legacyChangelog = `# Changelog
+
+## [2.0.0] - 2024-01-01
+
+### Fixed
+
+- Fix the crash
+
+### Added
+
+- Add the export
+
+<!-- changelog-disable unsorted-change-type, empty-version -->
+## [1.1.0] - 2016-05-01
+
+### Removed
+
+- Remove the import
+
+### Added
+
+- Add the import
+
+## [1.0.1] - 2016-04-01
+
+<!-- changelog-enable -->
+## [1.0.0] - 2016-03-01
+
+### Fixed
+
+- Fix the crash
+
+<!-- changelog-disable-next-line CL004 -->
+### Added
+
+- Initial release
+
+### Security
+
+- Escape the output
+
+### Changed
+
+- 
+## [0.1.0]
+`
+
+func TestValidateChangelogDirectives(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader(legacyChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Directives) != 3 || c.Directives[0].Position.Line != 13 || c.Directives[0].Target.Line != 14 || c.Directives[2].Action != "disable-next-line" {
+		t.Fatalf("Unexpected directives: %+v", c.Directives)
+	}
+
+	// The directives are not part of the version notes
+	if c.Versions[2].Notes != "" {
+		t.Fatalf("Unexpected notes: %q", c.Versions[2].Notes)
+	}
+
+	assertDirectiveIssues(t, c)
+}
+
+func TestValidateChangelogDirectivesWritten(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader(legacyChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bb bytes.Buffer
+	if err := writer.Write(&bb, c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The directives are written before the lines they precede, suppressing the same issues
+	c, err = parser.Parse(&bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertDirectiveIssues(t, c)
+}
+
+func assertDirectiveIssues(t *testing.T, c *validateachangelog.Changelog) {
+	err := Validate(c, nil)
+	if err == nil {
+		t.Fatal()
+	}
+
+	var issues []string
+	for _, issue := range err.(*ValidationError).Issues {
+		issues = append(issues, issue.Rule+"@"+issue.Version)
+	}
+
+	// The 2.0.0 and the 1.0.0 Changed sections are still reported (as well as the empty entry and the missing date)
+	expected := "CL004@2.0.0,CL004@1.0.0,CL007@1.0.0,CL002@0.1.0,CL005@0.1.0"
+	if strings.Join(issues, ",") != expected {
+		t.Fatalf("Expected issues %s. Got: %v", expected, issues)
+	}
+}
+
+func TestValidateChangelogInvalidDirective(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader("# Changelog\n\n<!-- changelog-disable unsorted-changetype -->\n## [1.0.0] - 2024-01-01\n\n### Added\n\n- Add the export\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Validate(c, nil)
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Rule != "CL018" || issues[0].Line != 3 {
+		t.Fatalf("Unexpected issues: %v", issues)
+	}
+}
@@ -1,6 +1,10 @@
 package validator
 
-import "strings"
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
 
 type ValidationError struct {
 	Issues []ValidationIssue `json:"issues"`
@@ -16,23 +20,51 @@ func (v *ValidationError) Error() string {
 	return sb.String()
 }
 
-func (v *ValidationError) pushIssue(version, section, error string) {
-	v.Issues = append(v.Issues, ValidationIssue{
-		Version: version,
-		Section: section,
-		Error:   error,
-	})
+// HasErrors returns true if one of the issues has the error severity (warnings and infos should not fail the
+// validation)
+func (v *ValidationError) HasErrors() bool {
+	for _, issue := range v.Issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (v *ValidationError) hasIssues() bool {
 	return len(v.Issues) > 0
 }
 
+// sortIssues sorts the issues by position, the ones without position last
+func (v *ValidationError) sortIssues() {
+	sort.SliceStable(v.Issues, func(i, j int) bool {
+		a, b := v.Issues[i], v.Issues[j]
+
+		switch {
+		case a.Line == 0 || b.Line == 0:
+			return a.Line != 0 && b.Line == 0
+		case a.Line != b.Line:
+			return a.Line < b.Line
+		default:
+			return a.Column < b.Column
+		}
+	})
+}
+
 type ValidationIssue struct {
+	// Rule contains the ID of the rule reporting the issue (e.g. CL004)
+	Rule string `json:"rule"`
+	// Severity contains the severity of the issue
+	Severity Severity `json:"severity"`
 	// Version contains the version where the error happens (when possible)
 	Version string `json:"version"`
 	// Section contains the section where the error happens (when possible)
 	Section string `json:"section"`
+	// Line contains the line (1-based) where the error happens (0 when unknown)
+	Line int `json:"line"`
+	// Column contains the column (1-based) where the error happens (0 when unknown)
+	Column int `json:"column"`
 	// Error is the human formatted error message
 	Error string `json:"error"`
 }
@@ -42,6 +74,9 @@ func (vi *ValidationIssue) String() string {
 
 	sb.WriteString("[")
 
+	if vi.Line > 0 {
+		sb.WriteString("line: " + strconv.Itoa(vi.Line) + ", column: " + strconv.Itoa(vi.Column) + ", ")
+	}
 	if vi.Version != "" {
 		sb.WriteString("version: " + vi.Version + ", ")
 	} else {
@@ -53,7 +88,16 @@ func (vi *ValidationIssue) String() string {
 		sb.WriteString("section: n/a")
 	}
 
-	sb.WriteString("]: " + vi.Error)
+	sb.WriteString("]: ")
+
+	if vi.Rule != "" {
+		sb.WriteString(vi.Rule + " ")
+	}
+	if vi.Severity != "" {
+		sb.WriteString(string(vi.Severity) + ": ")
+	}
+
+	sb.WriteString(vi.Error)
 
 	return sb.String()
 }
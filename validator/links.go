@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+var compareLinkRegex = regexp.MustCompile(`/compare/([^/?#]+?)\.\.\.?([^/?#]+)`)
+
+// checkMissingLink makes sure each version has a link reference definition
+func checkMissingLink(c *validateachangelog.Changelog, _ *Options, r *reporter) {
+	for _, version := range c.Versions {
+		if _, exists := c.GetLink(version.Version); !exists {
+			r.Report(version.Position, version.Version, "", fmt.Sprintf("missing link reference definition `[%s]`", version.Version))
+		}
+	}
+}
+
+// checkOrphanLink makes sure each version link reference definition points to an existing version
+func checkOrphanLink(c *validateachangelog.Changelog, _ *Options, r *reporter) {
+	for _, label := range c.Links.Keys() {
+		if !strings.EqualFold(label, unreleasedVersion) && !internal.IsValidSemVer(label) {
+			continue
+		}
+
+		found := false
+		for _, version := range c.Versions {
+			if strings.EqualFold(label, version.Version) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			r.Report(c.LinkPositions[label], label, "", fmt.Sprintf("link reference definition `[%s]` does not match any version", label))
+		}
+	}
+}
+
+// checkCompareLink makes sure compare links match the neighbouring versions
+func checkCompareLink(c *validateachangelog.Changelog, _ *Options, r *reporter) {
+	for i, version := range c.Versions {
+		// The oldest version has nothing to compare with
+		if i == len(c.Versions)-1 {
+			break
+		}
+
+		label, url := findLink(c, version.Version)
+		if url == "" {
+			continue
+		}
+
+		matches := compareLinkRegex.FindStringSubmatch(url)
+		if len(matches) == 0 {
+			continue
+		}
+
+		previousVersion := c.Versions[i+1].Version
+
+		baseRef, headRef := matches[1], matches[2]
+
+		validHead := refMatchesVersion(headRef, version.Version)
+		if version.Version == unreleasedVersion {
+			validHead = strings.EqualFold(headRef, "HEAD") || refMatchesVersion(headRef, version.Version)
+		}
+
+		if !validHead || !refMatchesVersion(baseRef, previousVersion) {
+			r.Report(c.LinkPositions[label], version.Version, "", fmt.Sprintf("compare link range `%s...%s` does not match the version range (expected %s...%s)", baseRef, headRef, previousVersion, version.Version))
+		}
+	}
+}
+
+// findLink returns the label and the URL of the link reference definition matching the version
+func findLink(c *validateachangelog.Changelog, version string) (string, string) {
+	for _, label := range c.Links.Keys() {
+		if strings.EqualFold(label, version) {
+			url, _ := c.Links.Get(label)
+			return label, url
+		}
+	}
+
+	return "", ""
+}
+
+// refMatchesVersion returns true if the git reference (tag) designates the version (e.g. v1.0.0 for 1.0.0)
+func refMatchesVersion(ref, version string) bool {
+	if !strings.HasSuffix(ref, version) {
+		return false
+	}
+
+	// Make sure the version is not part of a bigger one (e.g. v11.0.0 for 1.0.0)
+	prefix := ref[:len(ref)-len(version)]
+	if prefix == "" {
+		return true
+	}
+
+	last := prefix[len(prefix)-1]
+
+	return (last < '0' || last > '9') && last != '.'
+}
@@ -0,0 +1,174 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+func TestValidateChangelogValidLinks(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("Unreleased", ""),
+			newVersion("1.2.0", ""),
+			newVersion("1.1.0", ""),
+			newVersion("1.0.0", ""),
+		},
+		Links: *internal.NewSortedMap([]string{"unreleased", "1.2.0", "1.1.0", "1.0.0", "Keep"}, map[string]string{
+			"unreleased": "https://github.com/vold-lu/validate-a-changelog/compare/v1.2.0...HEAD",
+			"1.2.0":      "https://github.com/vold-lu/validate-a-changelog/compare/v1.1.0...v1.2.0",
+			"1.1.0":      "https://github.com/vold-lu/validate-a-changelog/compare/v1.0.0...v1.1.0",
+			"1.0.0":      "https://github.com/vold-lu/validate-a-changelog/releases/tag/v1.0.0",
+			"Keep":       "https://keepachangelog.com",
+		}),
+	}
+
+	if err := Validate(c, &Options{
+		AllowMissingReleaseDate: true,
+		AllowEmptyVersion:       true,
+		CheckMissingLink:        true,
+		CheckOrphanLink:         true,
+		CheckCompareLink:        true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateChangelogMissingLink(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("Unreleased", ""),
+			newVersion("1.2.0", ""),
+			newVersion("1.1.0", ""),
+			newVersion("1.0.0", ""),
+		},
+		Links: *internal.NewSortedMap([]string{"unreleased", "1.2.0", "1.0.0"}, map[string]string{
+			"unreleased": "https://github.com/vold-lu/validate-a-changelog/compare/v1.2.0...HEAD",
+			"1.2.0":      "https://github.com/vold-lu/validate-a-changelog/compare/v1.1.0...v1.2.0",
+			"1.0.0":      "https://github.com/vold-lu/validate-a-changelog/releases/tag/v1.0.0",
+		}),
+	}
+
+	if err := Validate(c, &Options{
+		AllowMissingReleaseDate:     true,
+		AllowEmptyVersion:           true,
+		AllowInvalidChangeType:      true,
+		AllowInvalidChangeTypeOrder: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := Validate(c, &Options{
+		AllowMissingReleaseDate: true,
+		AllowEmptyVersion:       true,
+		CheckMissingLink:        true,
+		CheckOrphanLink:         true,
+		CheckCompareLink:        true,
+	})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Version != "1.1.0" {
+		t.Fatalf("Expected 1 missing link issue for 1.1.0. Got: %v", issues)
+	}
+}
+
+func TestValidateChangelogOrphanLink(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("Unreleased", ""),
+			newVersion("1.2.0", ""),
+			newVersion("1.1.0", ""),
+			newVersion("1.0.0", ""),
+		},
+		Links: *internal.NewSortedMap([]string{"unreleased", "1.2.0", "1.1.0", "1.0.0", "0.9.0"}, map[string]string{
+			"unreleased": "https://github.com/vold-lu/validate-a-changelog/compare/v1.2.0...HEAD",
+			"1.2.0":      "https://github.com/vold-lu/validate-a-changelog/compare/v1.1.0...v1.2.0",
+			"1.1.0":      "https://github.com/vold-lu/validate-a-changelog/compare/v1.0.0...v1.1.0",
+			"1.0.0":      "https://github.com/vold-lu/validate-a-changelog/releases/tag/v1.0.0",
+			"0.9.0":      "https://github.com/vold-lu/validate-a-changelog/releases/tag/v0.9.0",
+		}),
+		LinkPositions: map[string]validateachangelog.Position{"0.9.0": {Line: 24, Column: 1}},
+	}
+
+	err := Validate(c, &Options{
+		AllowMissingReleaseDate: true,
+		AllowEmptyVersion:       true,
+		CheckMissingLink:        true,
+		CheckOrphanLink:         true,
+		CheckCompareLink:        true,
+	})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Version != "0.9.0" || issues[0].Line != 24 {
+		t.Fatalf("Expected 1 orphan link issue for 0.9.0 at line 24. Got: %v", issues)
+	}
+}
+
+func TestValidateChangelogInvalidCompareLink(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("Unreleased", ""),
+			newVersion("1.2.0", ""),
+			newVersion("1.1.0", ""),
+			newVersion("1.0.0", ""),
+		},
+		Links: *internal.NewSortedMap([]string{"unreleased", "1.2.0", "1.1.0", "1.0.0"}, map[string]string{
+			"unreleased": "https://github.com/vold-lu/validate-a-changelog/compare/v1.1.0...HEAD",
+			"1.2.0":      "https://github.com/vold-lu/validate-a-changelog/compare/v1.0.0...v1.2.0",
+			"1.1.0":      "https://github.com/vold-lu/validate-a-changelog/compare/v11.0.0...v1.1.0",
+			"1.0.0":      "https://github.com/vold-lu/validate-a-changelog/releases/tag/v1.0.0",
+		}),
+	}
+
+	err := Validate(c, &Options{
+		AllowMissingReleaseDate: true,
+		AllowEmptyVersion:       true,
+		CheckMissingLink:        true,
+		CheckOrphanLink:         true,
+		CheckCompareLink:        true,
+	})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 3 {
+		t.Fatalf("Expected 3 compare link issues. Got: %v", issues)
+	}
+
+	for i, version := range []string{"Unreleased", "1.2.0", "1.1.0"} {
+		if issues[i].Version != version {
+			t.Logf("Expected compare link issue for %s. Got: %s", version, issues[i].Version)
+			t.Fail()
+		}
+	}
+}
+
+func TestRefMatchesVersion(t *testing.T) {
+	cases := []struct {
+		Ref     string
+		Version string
+		Matches bool
+	}{
+		{Ref: "v1.0.0", Version: "1.0.0", Matches: true},
+		{Ref: "1.0.0", Version: "1.0.0", Matches: true},
+		{Ref: "release-1.0.0", Version: "1.0.0", Matches: true},
+		{Ref: "v11.0.0", Version: "1.0.0", Matches: false},
+		{Ref: "v1.1.0.0", Version: "1.0.0", Matches: false},
+		{Ref: "v1.0.1", Version: "1.0.0", Matches: false},
+	}
+
+	for _, c := range cases {
+		if matches := refMatchesVersion(c.Ref, c.Version); matches != c.Matches {
+			t.Logf("refMatchesVersion(%s, %s). Got %v, wanted %v", c.Ref, c.Version, matches, c.Matches)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package validator
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/manifest"
+)
+
+// checkManifestVersion makes sure the latest release matches the version declared by the project manifest
+func checkManifestVersion(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	// The rule may be enabled (Options.Rules) without manifest
+	if opts.Manifest == "" {
+		return
+	}
+
+	version, e := manifest.ReadVersion(opts.Manifest)
+	if e != nil {
+		r.Report(validateachangelog.Position{}, "", "", fmt.Sprintf("unable to read manifest version: %s", e))
+		return
+	}
+
+	latestRelease := c.LatestRelease()
+	if latestRelease == nil {
+		r.Report(c.TitlePosition, "", "", fmt.Sprintf("no release matching version %s declared in %s", version, filepath.Base(opts.Manifest)))
+		return
+	}
+
+	if latestRelease.Version != version {
+		r.Report(latestRelease.Position, latestRelease.Version, "", fmt.Sprintf("latest release differs from version %s declared in %s", version, filepath.Base(opts.Manifest)))
+	}
+}
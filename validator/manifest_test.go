@@ -0,0 +1,47 @@
+package validator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+)
+
+func TestValidateChangelogManifest(t *testing.T) {
+	changelog := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("Unreleased", "", "Added"),
+			newVersion("1.2.0", "2025-03-01", "Added"),
+			newVersion("1.1.0", "2025-02-01", "Added"),
+		},
+	}
+
+	dir := t.TempDir()
+
+	cases := []struct {
+		Filename string
+		Value    string
+		IsValid  bool
+	}{
+		{Filename: "package.json", Value: `{"version": "1.2.0"}`, IsValid: true},
+		{Filename: "VERSION", Value: "1.3.0\n", IsValid: false},
+		{Filename: "pom.xml", Value: "<version>1.2.0</version>", IsValid: false},
+		{Filename: "missing.json", IsValid: false},
+	}
+
+	for _, c := range cases {
+		filename := filepath.Join(dir, c.Filename)
+		if c.Value != "" {
+			if err := os.WriteFile(filename, []byte(c.Value), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		err := Validate(changelog, &Options{Manifest: filename})
+		if (err == nil) != c.IsValid {
+			t.Logf("Validate() with manifest %s. Got: %v", c.Filename, err)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/vold-lu/validate-a-changelog"
+)
+
+// Severity is the severity of the issues reported by a rule
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+	// SeverityOff disables the rule
+	SeverityOff Severity = "off"
+)
+
+// ParseSeverity parses the severity name (error, warning, info or off)
+func ParseSeverity(s string) (Severity, error) {
+	switch severity := Severity(strings.ToLower(strings.TrimSpace(s))); severity {
+	case SeverityError, SeverityWarning, SeverityInfo, SeverityOff:
+		return severity, nil
+	default:
+		return "", fmt.Errorf("invalid severity: %s (expected error, warning, info or off)", s)
+	}
+}
+
+// Rule is a check of the changelog, identified by a stable ID (e.g. CL004) and name (e.g. unsorted-change-type). Custom
+// rules can be added to the built-in ones with Register (for every validation) or Options.CustomRules.
+type Rule interface {
+	ID() string
+	Name() string
+	// Check reports the issues of the changelog (which has at least one version)
+	Check(c *validateachangelog.Changelog, r Reporter)
+}
+
+// Reporter collects the issues reported by a rule
+type Reporter interface {
+	// Report reports an issue at the position (zero if unknown), the version and section being empty if not relevant
+	Report(position validateachangelog.Position, version, section, message string)
+}
+
+// NewRule returns a rule running the check function
+func NewRule(id, name string, check func(c *validateachangelog.Changelog, r Reporter)) Rule {
+	return &funcRule{id: id, name: name, check: check}
+}
+
+type funcRule struct {
+	id, name string
+	check    func(c *validateachangelog.Changelog, r Reporter)
+}
+
+func (f *funcRule) ID() string {
+	return f.id
+}
+
+func (f *funcRule) Name() string {
+	return f.name
+}
+
+func (f *funcRule) Check(c *validateachangelog.Changelog, r Reporter) {
+	f.check(c, r)
+}
+
+// rule is a built-in or registered rule
+type rule struct {
+	ID   string
+	Name string
+	// enabled returns true if the rule runs when no severity is configured for it (Options.Rules)
+	enabled func(opts *Options) bool
+	check   func(c *validateachangelog.Changelog, opts *Options, r *reporter)
+}
+
+// rules contains the rules of the validator, in execution order
+var rules []*rule
+
+// The rules are initialized in init as some checks look them up (e.g. checkInvalidDirective)
+func init() {
+	rules = []*rule{
+		{ID: "CL001", Name: "invalid-version", check: checkInvalidVersion},
+		{ID: "CL002", Name: "missing-release-date", check: checkMissingReleaseDate, enabled: func(opts *Options) bool {
+			return !opts.AllowMissingReleaseDate
+		}},
+		{ID: "CL003", Name: "invalid-change-type", check: checkInvalidChangeType, enabled: func(opts *Options) bool {
+			return !opts.AllowInvalidChangeType
+		}},
+		{ID: "CL004", Name: "unsorted-change-type", check: checkUnsortedChangeType, enabled: func(opts *Options) bool {
+			return !opts.AllowInvalidChangeTypeOrder
+		}},
+		{ID: "CL005", Name: "empty-version", check: checkEmptyVersion, enabled: func(opts *Options) bool {
+			return !opts.AllowEmptyVersion
+		}},
+		{ID: "CL006", Name: "unsorted-version", check: checkUnsortedVersion},
+		{ID: "CL007", Name: "empty-entry", check: checkEmptyEntry},
+		{ID: "CL008", Name: "yanked-unreleased", check: checkYankedUnreleased},
+		emptyChangelogRule,
+		{ID: "CL010", Name: "missing-link", check: checkMissingLink, enabled: func(opts *Options) bool {
+			return opts.CheckMissingLink
+		}},
+		{ID: "CL011", Name: "orphan-link", check: checkOrphanLink, enabled: func(opts *Options) bool {
+			return opts.CheckOrphanLink
+		}},
+		{ID: "CL012", Name: "compare-link", check: checkCompareLink, enabled: func(opts *Options) bool {
+			return opts.CheckCompareLink
+		}},
+		{ID: "CL013", Name: "semver-bump", check: checkSemVerBump, enabled: func(opts *Options) bool {
+			return opts.CheckSemVerBump
+		}},
+		{ID: "CL014", Name: "missing-git-tag", check: checkMissingGitTag, enabled: func(opts *Options) bool {
+			return opts.Repository != ""
+		}},
+		{ID: "CL015", Name: "git-tag-date", check: checkGitTagDate, enabled: func(opts *Options) bool {
+			return opts.Repository != ""
+		}},
+		{ID: "CL016", Name: "orphan-git-tag", check: checkOrphanGitTag, enabled: func(opts *Options) bool {
+			return opts.Repository != ""
+		}},
+		{ID: "CL017", Name: "manifest-version", check: checkManifestVersion, enabled: func(opts *Options) bool {
+			return opts.Manifest != ""
+		}},
+		{ID: "CL018", Name: "invalid-directive", check: checkInvalidDirective},
+		{ID: "CL019", Name: "released-version-edit", check: checkReleasedVersionEdit, enabled: func(opts *Options) bool {
+			return opts.Base != nil
+		}},
+	}
+}
+
+var (
+	mutex           sync.RWMutex
+	registeredRules []*rule
+)
+
+// Register adds the custom rule to the validator, run after the built-in ones with the error severity by default
+// (see Options.Rules). It panics if the ID or name is empty or already used.
+func Register(r Rule) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if err := checkRule(allRulesLocked(), r); err != nil {
+		panic(fmt.Sprintf("validator: %s", err))
+	}
+
+	registeredRules = append(registeredRules, customRule(r))
+}
+
+// checkRule returns an error if the ID or name of the custom rule is empty or used by one of the rules
+func checkRule(all []*rule, r Rule) error {
+	if r.ID() == "" || r.Name() == "" {
+		return fmt.Errorf("rule ID and name are required")
+	}
+
+	for _, existing := range all {
+		if strings.EqualFold(existing.ID, r.ID()) || strings.EqualFold(existing.Name, r.Name()) ||
+			strings.EqualFold(existing.ID, r.Name()) || strings.EqualFold(existing.Name, r.ID()) {
+			return fmt.Errorf("rule %s (%s) is already registered", r.ID(), r.Name())
+		}
+	}
+
+	return nil
+}
+
+// customRule returns the rule running the custom one
+func customRule(r Rule) *rule {
+	return &rule{
+		ID:   r.ID(),
+		Name: r.Name(),
+		check: func(c *validateachangelog.Changelog, _ *Options, issues *reporter) {
+			r.Check(c, issues)
+		},
+	}
+}
+
+// allRules returns the built-in rules followed by the registered ones
+func allRules() []*rule {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	return allRulesLocked()
+}
+
+func allRulesLocked() []*rule {
+	all := make([]*rule, 0, len(rules)+len(registeredRules))
+	all = append(all, rules...)
+
+	return append(all, registeredRules...)
+}
+
+// emptyChangelogRule is run before the other rules, which are skipped if the changelog has no versions
+var emptyChangelogRule = &rule{ID: "CL009", Name: "empty-changelog"}
+
+// RuleID returns the ID of the rule designated by its ID or name (case-insensitive), false if there is none
+func RuleID(idOrName string) (string, bool) {
+	return ruleID(allRules(), idOrName)
+}
+
+// rules returns the built-in and registered rules followed by the custom ones of the options
+func (opts *Options) rules() []*rule {
+	all := allRules()
+	for _, r := range opts.CustomRules {
+		all = append(all, customRule(r))
+	}
+
+	return all
+}
+
+// checkCustomRules returns an error if the ID or name of a custom rule of the options is empty or used by another rule
+func (opts *Options) checkCustomRules() error {
+	all := allRules()
+	for _, r := range opts.CustomRules {
+		if err := checkRule(all, r); err != nil {
+			return fmt.Errorf("invalid custom rule: %w", err)
+		}
+
+		all = append(all, customRule(r))
+	}
+
+	return nil
+}
+
+// ruleID returns the ID of the rule designated by its ID or name, the custom rules of the options included
+func (opts *Options) ruleID(idOrName string) (string, bool) {
+	return ruleID(opts.rules(), idOrName)
+}
+
+// ParseRuleSeverity parses a rule severity setting (e.g. CL004=warning or unsorted-change-type=off), returning the
+// ID of the rule
+func ParseRuleSeverity(s string) (string, Severity, error) {
+	name, value, found := strings.Cut(s, "=")
+	if !found {
+		return "", "", fmt.Errorf("invalid rule setting: %s (expected <rule>=<severity>)", s)
+	}
+
+	id, exists := RuleID(strings.TrimSpace(name))
+	if !exists {
+		return "", "", fmt.Errorf("unknown rule: %s", strings.TrimSpace(name))
+	}
+
+	severity, err := ParseSeverity(value)
+	if err != nil {
+		return "", "", err
+	}
+
+	return id, severity, nil
+}
+
+func ruleID(rules []*rule, idOrName string) (string, bool) {
+	for _, r := range rules {
+		if strings.EqualFold(r.ID, idOrName) || strings.EqualFold(r.Name, idOrName) {
+			return r.ID, true
+		}
+	}
+
+	return "", false
+}
+
+// severity returns the severity of the rule issues, SeverityOff if the rule is disabled
+func (opts *Options) severity(r *rule) Severity {
+	for idOrName, severity := range opts.Rules {
+		if strings.EqualFold(idOrName, r.ID) || strings.EqualFold(idOrName, r.Name) {
+			return severity
+		}
+	}
+
+	if r.enabled != nil && !r.enabled(opts) {
+		return SeverityOff
+	}
+
+	return SeverityError
+}
+
+// reporter reports the issues of a rule to the validation error
+type reporter struct {
+	rule     *rule
+	severity Severity
+	err      *ValidationError
+	state    *state
+}
+
+// state contains the data shared by the rules during a validation (e.g. the git tags)
+type state struct {
+	tagsRead bool
+	tags     map[string]gitTag
+	tagsErr  error
+}
+
+func (r *reporter) Report(position validateachangelog.Position, version, section, message string) {
+	r.err.Issues = append(r.err.Issues, ValidationIssue{
+		Rule:     r.rule.ID,
+		Severity: r.severity,
+		Version:  version,
+		Section:  section,
+		Line:     position.Line,
+		Column:   position.Column,
+		Error:    message,
+	})
+}
@@ -0,0 +1,334 @@
+package validator
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+	"github.com/vold-lu/validate-a-changelog/parser"
+)
+
+// unsortedChangelog has a missing release date (CL002) and unsorted change types (CL004)
+const unsortedChangelog = `# Changelog
+
+## [1.0.0]
+
+### Removed
+
+- Test description
+
+### Added
+
+- Test description
+`
+
+func TestValidateChangelogRuleSeverity(t *testing.T) {
+	cases := []struct {
+		Rules     map[string]Severity
+		Issues    []string
+		HasErrors bool
+	}{
+		{Rules: nil, Issues: []string{"CL002 error", "CL004 error"}, HasErrors: true},
+		{Rules: map[string]Severity{"CL004": SeverityWarning}, Issues: []string{"CL002 error", "CL004 warning"}, HasErrors: true},
+		{Rules: map[string]Severity{"unsorted-change-type": SeverityInfo, "CL002": SeverityOff}, Issues: []string{"CL004 info"}, HasErrors: false},
+		{Rules: map[string]Severity{"cl004": SeverityOff, "missing-release-date": SeverityOff}, Issues: nil},
+	}
+
+	changelog, err := parser.Parse(strings.NewReader(unsortedChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range cases {
+		t.Run(strings.Join(c.Issues, ","), func(t *testing.T) {
+			err := Validate(changelog, &Options{Rules: c.Rules})
+
+			if len(c.Issues) == 0 {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+
+			validationErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("Expected validation error. Got: %v", err)
+			}
+
+			var issues []string
+			for _, issue := range validationErr.Issues {
+				issues = append(issues, issue.Rule+" "+string(issue.Severity))
+			}
+
+			if strings.Join(issues, ",") != strings.Join(c.Issues, ",") {
+				t.Logf("Expected issues %v. Got: %v", c.Issues, issues)
+				t.Fail()
+			}
+
+			if validationErr.HasErrors() != c.HasErrors {
+				t.Logf("Expected HasErrors %v. Got: %v", c.HasErrors, validationErr.HasErrors())
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestValidateChangelogRuleOverridesOptions(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader(unsortedChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The severity enables the rule disabled by the options
+	err = Validate(c, &Options{
+		AllowMissingReleaseDate:     true,
+		AllowInvalidChangeTypeOrder: true,
+		Rules:                       map[string]Severity{"CL004": SeverityWarning},
+	})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Rule != "CL004" || issues[0].Severity != SeverityWarning {
+		t.Fatalf("Unexpected issues: %v", issues)
+	}
+
+	if !strings.Contains(issues[0].String(), "]: CL004 warning: unsorted change type") {
+		t.Fatalf("Unexpected issue string: %s", issues[0].String())
+	}
+}
+
+func TestValidateChangelogIssueOrder(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader(unsortedChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Links = *internal.NewSortedMap([]string{"0.1.0"}, map[string]string{"0.1.0": "https://example.org"})
+
+	err = Validate(c, &Options{CheckMissingLink: true, CheckOrphanLink: true})
+	if err == nil {
+		t.Fatal()
+	}
+
+	// Issues are sorted by position, the ones without position last
+	var rules []string
+	for _, issue := range err.(*ValidationError).Issues {
+		rules = append(rules, issue.Rule)
+	}
+
+	if strings.Join(rules, ",") != "CL002,CL010,CL004,CL011" {
+		t.Fatalf("Unexpected issue order: %v", rules)
+	}
+}
+
+func TestParseRuleSeverity(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ID       string
+		Severity Severity
+		IsValid  bool
+	}{
+		{Value: "CL004=warning", ID: "CL004", Severity: SeverityWarning, IsValid: true},
+		{Value: "unsorted-version=Info", ID: "CL006", Severity: SeverityInfo, IsValid: true},
+		{Value: "cl013=off", ID: "CL013", Severity: SeverityOff, IsValid: true},
+		{Value: "CL004", IsValid: false},
+		{Value: "CL999=error", IsValid: false},
+		{Value: "CL004=fatal", IsValid: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Value, func(t *testing.T) {
+			id, severity, err := ParseRuleSeverity(c.Value)
+			if (err == nil) != c.IsValid {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if id != c.ID || severity != c.Severity {
+				t.Logf("Expected %s=%s. Got: %s=%s", c.ID, c.Severity, id, severity)
+				t.Fail()
+			}
+		})
+	}
+}
+
+// ticketRule requires a ticket key (e.g. ABC-123) in every Security entry
+type ticketRule struct{}
+
+func (ticketRule) ID() string {
+	return "ORG001"
+}
+
+func (ticketRule) Name() string {
+	return "security-ticket"
+}
+
+func (ticketRule) Check(c *validateachangelog.Changelog, r Reporter) {
+	for _, version := range c.Versions {
+		entries, _ := version.Entries.Get("Security")
+
+		for _, entry := range entries {
+			if !ticketRegex.MatchString(entry.Description) {
+				r.Report(entry.Position, version.Version, "Security", "missing ticket key in security entry")
+			}
+		}
+	}
+}
+
+var ticketRegex = regexp.MustCompile(`\b[A-Z]+-[0-9]+\b`)
+
+var noMiscRule = NewRule("ORG002", "no-misc", func(c *validateachangelog.Changelog, r Reporter) {
+	for _, version := range c.Versions {
+		for _, changeType := range version.Entries.Keys() {
+			entries, _ := version.Entries.Get(changeType)
+
+			validateachangelog.WalkEntries(entries, func(entry *validateachangelog.Entry, _ int) {
+				if strings.Contains(strings.ToLower(entry.Description), "misc") {
+					r.Report(entry.Position, version.Version, changeType, "entries must not mention `misc`")
+				}
+			})
+		}
+	}
+})
+
+func TestValidateChangelogCustomRules(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "1.0.0",
+				Entries: *internal.NewSortedMap([]string{"Fixed", "Security"}, map[string][]validateachangelog.Entry{
+					"Fixed": {
+						{Description: "Misc fixes", Position: validateachangelog.Position{Line: 5, Column: 1}},
+					},
+					"Security": {
+						{Description: "Fix XSS (ABC-123)", Position: validateachangelog.Position{Line: 9, Column: 1}},
+						{Description: "Fix CSRF", Position: validateachangelog.Position{Line: 10, Column: 1}},
+					},
+				}),
+			},
+		},
+	}
+
+	cases := []struct {
+		Rules  map[string]Severity
+		Issues []string
+	}{
+		{Rules: nil, Issues: []string{"ORG002 error", "ORG001 error"}},
+		{Rules: map[string]Severity{"security-ticket": SeverityWarning, "ORG002": SeverityOff}, Issues: []string{"ORG001 warning"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(strings.Join(tc.Issues, ","), func(t *testing.T) {
+			err := Validate(c, &Options{AllowMissingReleaseDate: true, Rules: tc.Rules, CustomRules: []Rule{ticketRule{}, noMiscRule}})
+			if err == nil {
+				t.Fatal()
+			}
+
+			var issues []string
+			for _, issue := range err.(*ValidationError).Issues {
+				issues = append(issues, issue.Rule+" "+string(issue.Severity))
+			}
+
+			if strings.Join(issues, ",") != strings.Join(tc.Issues, ",") {
+				t.Logf("Expected issues %v. Got: %v", tc.Issues, issues)
+				t.Fail()
+			}
+		})
+	}
+
+	// The custom rules only apply to the validation they are given to
+	if err := Validate(c, &Options{AllowMissingReleaseDate: true}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestValidateChangelogCustomRuleDirective(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader("# Changelog\n\n## [1.0.0] - 2024-01-01\n\n### Fixed\n\n<!-- changelog-disable-next-line no-misc -->\n- Misc fixes\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The directive designates an existing rule and suppresses its issue
+	if err := Validate(c, &Options{CustomRules: []Rule{noMiscRule}}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+// registerOnce prevents duplicate registrations when the tests run several times (-count)
+var registerOnce sync.Once
+
+func TestRegister(t *testing.T) {
+	// The registered rule applies to every validation: it must not report issues in the other tests
+	registerOnce.Do(func() {
+		Register(NewRule("ORG003", "registered-rule", func(*validateachangelog.Changelog, Reporter) {}))
+	})
+
+	// Registered rules can be configured like the built-in ones
+	if id, severity, err := ParseRuleSeverity("registered-rule=info"); err != nil || id != "ORG003" || severity != SeverityInfo {
+		t.Fatalf("Unexpected rule severity: %s=%s (%v)", id, severity, err)
+	}
+}
+
+func TestRegisterDuplicateRule(t *testing.T) {
+	cases := []Rule{
+		NewRule("CL004", "custom", nil),
+		NewRule("ORG100", "unsorted-change-type", nil),
+		NewRule("", "custom", nil),
+	}
+
+	for _, c := range cases {
+		t.Run(c.ID()+" "+c.Name(), func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fail()
+				}
+			}()
+
+			Register(c)
+		})
+	}
+}
+
+func TestValidateChangelogDuplicateCustomRule(t *testing.T) {
+	cases := [][]Rule{
+		{NewRule("CL004", "custom", nil)},
+		{NewRule("ORG100", "unsorted-change-type", nil)},
+		{NewRule("", "custom", nil)},
+		{NewRule("ORG100", "custom", nil), NewRule("ORG101", "org100", nil)},
+	}
+
+	changelog, err := parser.Parse(strings.NewReader(unsortedChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range cases {
+		t.Run(c[len(c)-1].ID()+" "+c[len(c)-1].Name(), func(t *testing.T) {
+			err := Validate(changelog, &Options{CustomRules: c})
+			if _, ok := err.(*ValidationError); err == nil || ok {
+				t.Fatalf("Expected an invalid custom rule error. Got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateChangelogRuleWithoutInput(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader(unsortedChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rules cross-checking a repository or a manifest do nothing without them
+	err = Validate(c, &Options{
+		AllowMissingReleaseDate:     true,
+		AllowInvalidChangeTypeOrder: true,
+		Rules:                       map[string]Severity{"CL014": SeverityError, "CL016": SeverityError, "CL017": SeverityError},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
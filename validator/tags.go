@@ -0,0 +1,148 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+// gitTag is a version tag of the git repository
+type gitTag struct {
+	Name string
+	// Date is the date (YYYY-MM-DD) of the tagged commit
+	Date string
+}
+
+// checkMissingGitTag makes sure each release is tagged
+func checkMissingGitTag(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	// The rule may be enabled (Options.Rules) without repository
+	if opts.Repository == "" {
+		return
+	}
+
+	tags, err := r.state.gitTags(opts)
+	if err != nil {
+		r.Report(validateachangelog.Position{}, "", "", fmt.Sprintf("unable to read git tags: %s", err))
+		return
+	}
+
+	for _, version := range c.Versions {
+		if version.Version == unreleasedVersion {
+			continue
+		}
+
+		if _, exists := tags[version.Version]; !exists {
+			r.Report(version.Position, version.Version, "", fmt.Sprintf("missing git tag `%s`", validateachangelog.TagName(opts.TagPattern, version.Version)))
+		}
+	}
+}
+
+// checkGitTagDate makes sure each release is tagged at its release date
+func checkGitTagDate(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	if opts.Repository == "" {
+		return
+	}
+
+	// Unreadable tags are reported by checkMissingGitTag
+	tags, err := r.state.gitTags(opts)
+	if err != nil {
+		return
+	}
+
+	for _, version := range c.Versions {
+		tag, exists := tags[version.Version]
+		if !exists || version.Version == unreleasedVersion {
+			continue
+		}
+
+		if version.ReleaseDate != nil && !version.ReleaseDate.IsZero() && version.ReleaseDate.Format("2006-01-02") != tag.Date {
+			r.Report(version.Position, version.Version, "", fmt.Sprintf("release date %s differs from git tag `%s` date %s", version.ReleaseDate.Format("2006-01-02"), tag.Name, tag.Date))
+		}
+	}
+}
+
+// checkOrphanGitTag makes sure each tag has a release
+func checkOrphanGitTag(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	if opts.Repository == "" {
+		return
+	}
+
+	// Unreadable tags are reported by checkMissingGitTag
+	tags, err := r.state.gitTags(opts)
+	if err != nil {
+		return
+	}
+
+	for _, version := range sortedTagVersions(tags) {
+		found := false
+		for _, v := range c.Versions {
+			if v.Version == version {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			r.Report(validateachangelog.Position{}, version, "", fmt.Sprintf("git tag `%s` has no changelog entry", tags[version].Name))
+		}
+	}
+}
+
+// gitTags returns the version tags of the repository, read once per validation as several rules use them
+func (s *state) gitTags(opts *Options) (map[string]gitTag, error) {
+	if !s.tagsRead {
+		s.tags, s.tagsErr = readTags(opts.Repository, opts.TagPattern)
+		s.tagsRead = true
+	}
+
+	return s.tags, s.tagsErr
+}
+
+// readTags returns the version tags of the git repository, indexed by version
+func readTags(dir, tagPattern string) (map[string]gitTag, error) {
+	// The dereferenced committer date is used for annotated tags, the committer date otherwise
+	out, err := internal.RunGit(dir, "for-each-ref", "--format=%(refname:short)%09%(committerdate:short)%09%(*committerdate:short)", "refs/tags")
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[string]gitTag{}
+
+	for _, line := range strings.Split(out, "\n") {
+		parts := strings.Split(line, "\t")
+		if len(parts) != 3 {
+			continue
+		}
+
+		version, ok := validateachangelog.TagVersion(tagPattern, parts[0])
+		if !ok || !internal.IsValidSemVer(version) {
+			continue
+		}
+
+		date := parts[1]
+		if parts[2] != "" {
+			date = parts[2]
+		}
+
+		tags[version] = gitTag{Name: parts[0], Date: date}
+	}
+
+	return tags, nil
+}
+
+// sortedTagVersions returns the versions of the tags, the most recent first
+func sortedTagVersions(tags map[string]gitTag) []string {
+	versions := make([]string, 0, len(tags))
+	for version := range tags {
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return compareVersions(versions[i], versions[j]) > 0
+	})
+
+	return versions
+}
@@ -0,0 +1,140 @@
+package validator
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+)
+
+func newTaggedRepository(t *testing.T, tags map[string]string) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	dir := t.TempDir()
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.name", "Test"},
+		{"config", "user.email", "test@example.org"},
+		{"config", "commit.gpgsign", "false"},
+		{"config", "tag.gpgsign", "false"},
+	} {
+		if _, err := internal.RunGit(dir, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for tag, date := range tags {
+		t.Setenv("GIT_AUTHOR_DATE", date+"T12:00:00Z")
+		t.Setenv("GIT_COMMITTER_DATE", date+"T12:00:00Z")
+
+		if _, err := internal.RunGit(dir, "commit", "-q", "--allow-empty", "-m", "Release "+tag); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := internal.RunGit(dir, "tag", "-a", "-m", tag, tag); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestValidateChangelogTags(t *testing.T) {
+	dir := newTaggedRepository(t, map[string]string{
+		"v1.0.0": "2025-01-01",
+		"v1.1.0": "2025-02-03",
+		"v1.2.0": "2025-03-01",
+		"latest": "2025-03-01",
+	})
+
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("Unreleased", "", "Added"),
+			newVersion("1.3.0", "2025-04-01", "Added"),
+			newVersion("1.1.0", "2025-02-01", "Added"),
+			newVersion("1.0.0", "2025-01-01", "Added"),
+		},
+	}
+
+	err := Validate(c, &Options{Repository: dir})
+	if err == nil {
+		t.Fatal("Validate() should have failed")
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 3 {
+		t.Fatalf("Expected 3 issues. Got: %v", issues)
+	}
+
+	wanted := []string{
+		"missing git tag `v1.3.0`",
+		"release date 2025-02-01 differs from git tag `v1.1.0` date 2025-02-03",
+		"git tag `v1.2.0` has no changelog entry",
+	}
+
+	for i, issue := range issues {
+		if issue.Error != wanted[i] {
+			t.Logf("Unexpected issue. Got: %s, wanted: %s", issue.Error, wanted[i])
+			t.Fail()
+		}
+	}
+}
+
+func TestValidateChangelogTagPattern(t *testing.T) {
+	dir := newTaggedRepository(t, map[string]string{
+		"release-1.0.0": "2025-01-01",
+		"v2.0.0":        "2025-02-01",
+	})
+
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("1.0.0", "2025-01-01", "Added"),
+		},
+	}
+
+	if err := Validate(c, &Options{Repository: dir, TagPattern: "release-{version}"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateChangelogTagsInvalidRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			newVersion("1.0.0", "2025-01-01", "Added"),
+		},
+	}
+
+	err := Validate(c, &Options{Repository: t.TempDir()})
+	if err == nil || !strings.Contains(err.Error(), "unable to read git tags") {
+		t.Fatalf("Expected git error. Got: %v", err)
+	}
+}
+
+func TestGitTagsReadOnce(t *testing.T) {
+	dir := newTaggedRepository(t, map[string]string{"v1.0.0": "2025-01-01"})
+	opts := &Options{Repository: dir}
+
+	s := &state{}
+	if tags, err := s.gitTags(opts); err != nil || len(tags) != 1 {
+		t.Fatalf("Unexpected tags: %v (%v)", tags, err)
+	}
+
+	// The tags are not read again (the repository being gone)
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if tags, err := s.gitTags(opts); err != nil || tags["1.0.0"].Date != "2025-01-01" {
+		t.Fatalf("Unexpected tags: %v (%v)", tags, err)
+	}
+}
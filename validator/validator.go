@@ -2,7 +2,7 @@ package validator
 
 import (
 	"fmt"
-	"regexp"
+	"strings"
 
 	"github.com/vold-lu/validate-a-changelog"
 	"github.com/vold-lu/validate-a-changelog/internal"
@@ -11,122 +11,219 @@ import (
 
 const unreleasedVersion = "Unreleased"
 
-var semverRegex = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
-
 type Options struct {
 	AllowEmptyVersion           bool
 	AllowMissingReleaseDate     bool
 	AllowInvalidChangeType      bool
 	AllowInvalidChangeTypeOrder bool
+
+	// ChangeTypes contains the allowed change types and their order (validateachangelog.DefaultChangeTypes when nil)
+	ChangeTypes validateachangelog.ChangeTypes
+
+	// CheckMissingLink reports versions without link reference definition
+	CheckMissingLink bool
+	// CheckOrphanLink reports version link reference definitions not matching any version
+	CheckOrphanLink bool
+	// CheckCompareLink reports compare links whose range does not match the neighbouring versions
+	CheckCompareLink bool
+
+	// CheckSemVerBump reports versions whose bump is too small for their entries (e.g. a patch release with Added entries)
+	CheckSemVerBump bool
+	// BumpRules maps change types to the bump they require (validateachangelog.DefaultBumpRules when nil)
+	BumpRules validateachangelog.BumpRules
+
+	// Repository is the git repository whose version tags are cross-checked against the releases (disabled when empty)
+	Repository string
+	// TagPattern is the naming of the version tags (validateachangelog.DefaultTagPattern when empty)
+	TagPattern string
+
+	// Manifest is the project manifest (package.json, Cargo.toml, VERSION...) whose version must match the latest
+	// release (disabled when empty)
+	Manifest string
+
+	// Rules maps rule IDs or names (e.g. CL004 or unsorted-change-type) to their severity, overriding the options
+	// above (SeverityOff disabling the rule, any other severity enabling it)
+	Rules map[string]Severity
+
+	// CustomRules contains the rules run after the built-in and registered ones for this validation only, with the
+	// error severity by default (their IDs and names must not be used by other rules, see Validate)
+	CustomRules []Rule
+
+	// Baseline contains the accepted issues, which are not reported (see NewBaseline)
+	Baseline *Baseline
+
+	// Base is the changelog of the base revision (e.g. the target branch of a pull request): only the issues of the
+	// versions and sections added or modified since are reported (disabled when nil)
+	Base *validateachangelog.Changelog
 }
 
+// Validate returns a *ValidationError containing the issues of the changelog (nil if there is none), or an error if the
+// options are invalid (e.g. a custom rule using the ID of a built-in one)
 func Validate(c *validateachangelog.Changelog, opts *Options) error {
 	if opts == nil {
 		opts = &Options{}
 	}
 
+	if err := opts.checkCustomRules(); err != nil {
+		return err
+	}
+
 	err := &ValidationError{}
 
-	if c == nil {
-		err.pushIssue("", "", "nil changelog")
+	if c == nil || len(c.Versions) == 0 {
+		if severity := opts.severity(emptyChangelogRule); severity != SeverityOff {
+			r := &reporter{rule: emptyChangelogRule, severity: severity, err: err}
 
-		return err
-	}
+			if c == nil {
+				r.Report(validateachangelog.Position{}, "", "", "nil changelog")
+			} else {
+				r.Report(c.TitlePosition, "", "", "no versions found in the changelog")
+			}
+		}
+	} else {
+		s := &state{}
 
-	if len(c.Versions) == 0 {
-		err.pushIssue("", "", "no versions found in the changelog")
+		for _, rule := range opts.rules() {
+			if rule.check == nil {
+				continue
+			}
 
-		return err
-	}
+			if severity := opts.severity(rule); severity != SeverityOff {
+				rule.check(c, opts, &reporter{rule: rule, severity: severity, err: err, state: s})
+			}
+		}
 
-	standardChangeTypes := internal.GetStandardChangeTypes()
-	standardChangeTypeNames := make([]string, len(standardChangeTypes))
+		if opts.Base != nil {
+			err.Issues = filterUnchanged(opts.Base, c, err.Issues)
+		}
 
-	i := 0
-	for changeType := range standardChangeTypes {
-		standardChangeTypeNames[i] = changeType
-		i++
+		err.Issues = suppressIssues(c, opts, err.Issues)
 	}
 
-	previousVersion := ""
+	if opts.Baseline != nil {
+		err.Issues = opts.Baseline.Filter(err.Issues)
+	}
+
+	if err.hasIssues() {
+		err.sortIssues()
+		return err
+	} else {
+		return nil
+	}
+}
 
+// checkInvalidVersion makes sure versions are valid SemVer versions
+func checkInvalidVersion(c *validateachangelog.Changelog, _ *Options, r *reporter) {
 	for _, version := range c.Versions {
-		// Make sure version is valid
-		if version.Version != unreleasedVersion && !semverRegex.MatchString(version.Version) {
-			err.pushIssue(version.Version, "", "invalid version")
+		if version.Version != unreleasedVersion && !internal.IsValidSemVer(version.Version) {
+			r.Report(version.Position, version.Version, "", "invalid version")
 		}
+	}
+}
 
-		// Make sure release have a date
-		if version.ReleaseDate == nil && !opts.AllowMissingReleaseDate && version.Version != unreleasedVersion {
-			err.pushIssue(version.Version, "", "missing release date in changelog entry")
+// checkMissingReleaseDate makes sure releases have a date
+func checkMissingReleaseDate(c *validateachangelog.Changelog, _ *Options, r *reporter) {
+	for _, version := range c.Versions {
+		if version.ReleaseDate == nil && version.Version != unreleasedVersion {
+			r.Report(version.Position, version.Version, "", "missing release date in changelog entry")
 		}
+	}
+}
 
-		// Make sure release contains entries
-		if version.Entries.Len() == 0 && !opts.AllowEmptyVersion && version.Version != unreleasedVersion {
-			err.pushIssue(version.Version, "", "no sections found in changelog entry")
-		}
+// checkInvalidChangeType makes sure sections have an allowed change type
+func checkInvalidChangeType(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	changeTypes := opts.changeTypes()
 
-		// Make sure entries have valid change type
-		if !opts.AllowInvalidChangeType {
-			for _, changeType := range version.Entries.Keys() {
-				if _, exists := standardChangeTypes[changeType]; !exists {
-					err.pushIssue(version.Version, changeType, fmt.Sprintf("invalid section `%s` in changelog entry (available values: %v)", changeType, standardChangeTypeNames))
-				}
+	for _, version := range c.Versions {
+		for _, changeType := range version.Entries.Keys() {
+			if !changeTypes.Has(changeType) {
+				r.Report(version.SectionPositions[changeType], version.Version, changeType, fmt.Sprintf("invalid section `%s` in changelog entry (available values: %v)", changeType, changeTypes.Names()))
 			}
 		}
+	}
+}
 
-		// Make sure version are in good order
-		if previousVersion != "" {
-			currentVersion := version.Version
+// checkUnsortedChangeType makes sure sections follow the change types order
+func checkUnsortedChangeType(c *validateachangelog.Changelog, opts *Options, r *reporter) {
+	changeTypes := opts.changeTypes()
 
-			if previousVersion == unreleasedVersion {
-				previousVersion = "99.99.99"
-			}
-			if version.Version == unreleasedVersion {
-				currentVersion = "99.99.99"
-			}
+	for _, version := range c.Versions {
+		previousChangeType := ""
 
-			if semver.Compare("v"+previousVersion, "v"+currentVersion) < 1 {
-				err.pushIssue(version.Version, "", "version is not in the right order")
+		for _, changeType := range version.Entries.Keys() {
+			if previousChangeType != "" && changeTypes.Weight(previousChangeType) > changeTypes.Weight(changeType) {
+				r.Report(version.SectionPositions[changeType], version.Version, changeType, fmt.Sprintf("unsorted change type in changelog entry (%s > %s)", changeType, previousChangeType))
 			}
+
+			previousChangeType = changeType
 		}
+	}
+}
 
-		// Validate that the change type is in the good order
-		if !opts.AllowInvalidChangeTypeOrder {
-			previousChangeType := ""
-
-			for _, changeType := range version.Entries.Keys() {
-				if previousChangeType != "" {
-
-					var previousChangeTypeWeight int
-					if val, ok := standardChangeTypes[previousChangeType]; ok {
-						previousChangeTypeWeight = val
-					} else {
-						previousChangeTypeWeight = 999
-					}
-
-					var currentChangeTypeWeight int
-					if val, ok := standardChangeTypes[changeType]; ok {
-						currentChangeTypeWeight = val
-					} else {
-						currentChangeTypeWeight = 999
-					}
-
-					if previousChangeTypeWeight > currentChangeTypeWeight {
-						err.pushIssue(version.Version, changeType, fmt.Sprintf("unsorted change type in changelog entry (%s > %s)", changeType, previousChangeType))
-					}
+// checkEmptyVersion makes sure releases contain entries
+func checkEmptyVersion(c *validateachangelog.Changelog, _ *Options, r *reporter) {
+	for _, version := range c.Versions {
+		if version.Entries.Len() == 0 && version.Version != unreleasedVersion {
+			r.Report(version.Position, version.Version, "", "no sections found in changelog entry")
+		}
+	}
+}
+
+// checkUnsortedVersion makes sure versions are in good order (following SemVer precedence)
+func checkUnsortedVersion(c *validateachangelog.Changelog, _ *Options, r *reporter) {
+	for i, version := range c.Versions {
+		if i > 0 && compareVersions(c.Versions[i-1].Version, version.Version) < 1 {
+			r.Report(version.Position, version.Version, "", "version is not in the right order")
+		}
+	}
+}
+
+// checkEmptyEntry makes sure entries (including nested ones) are not empty
+func checkEmptyEntry(c *validateachangelog.Changelog, _ *Options, r *reporter) {
+	for _, version := range c.Versions {
+		for _, changeType := range version.Entries.Keys() {
+			entries, _ := version.Entries.Get(changeType)
+
+			validateachangelog.WalkEntries(entries, func(entry *validateachangelog.Entry, _ int) {
+				if strings.TrimSpace(entry.Description) == "" {
+					r.Report(entry.Position, version.Version, changeType, "empty entry in changelog section")
 				}
+			})
+		}
+	}
+}
 
-				previousChangeType = changeType
-			}
+// checkYankedUnreleased makes sure the unreleased version is not yanked
+func checkYankedUnreleased(c *validateachangelog.Changelog, _ *Options, r *reporter) {
+	for _, version := range c.Versions {
+		if version.Yanked && version.Version == unreleasedVersion {
+			r.Report(version.Position, version.Version, "", "unreleased version cannot be yanked")
 		}
+	}
+}
 
-		previousVersion = version.Version
+// changeTypes returns the allowed change types
+func (opts *Options) changeTypes() validateachangelog.ChangeTypes {
+	if opts.ChangeTypes == nil {
+		return validateachangelog.DefaultChangeTypes()
 	}
 
-	if err.hasIssues() {
-		return err
-	} else {
-		return nil
+	return opts.ChangeTypes
+}
+
+// compareVersions compares two changelog versions following SemVer precedence, Unreleased being the greatest version.
+// The result will be 0 if a == b, -1 if a < b, or +1 if a > b.
+func compareVersions(a, b string) int {
+	if a == unreleasedVersion || b == unreleasedVersion {
+		switch {
+		case a == b:
+			return 0
+		case a == unreleasedVersion:
+			return 1
+		default:
+			return -1
+		}
 	}
+
+	return semver.Compare("v"+a, "v"+b)
 }
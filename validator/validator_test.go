@@ -8,6 +8,25 @@ import (
 	"github.com/vold-lu/validate-a-changelog/internal"
 )
 
+// newVersion returns a version with an entry in each of the sections (without release date when empty)
+func newVersion(version, releaseDate string, sections ...string) *validateachangelog.Version {
+	v := &validateachangelog.Version{
+		Version: version,
+		Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+	}
+
+	if releaseDate != "" {
+		date, _ := time.Parse("2006-01-02", releaseDate)
+		v.ReleaseDate = &date
+	}
+
+	for _, section := range sections {
+		_ = v.Entries.Set(section, []validateachangelog.Entry{{Description: "Something."}})
+	}
+
+	return v
+}
+
 func TestValidateEmptyChangelog(t *testing.T) {
 	c := &validateachangelog.Changelog{}
 
@@ -541,3 +560,261 @@ func TestValidateChangelogValidChangeTypeOrderAndNotAllowedWithCustomChangeType(
 		t.Fail()
 	}
 }
+
+func TestValidateChangelogConfiguredChangeTypes(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version:     "1.0.0",
+				ReleaseDate: nil,
+				Entries: *internal.NewSortedMap([]string{"Breaking", "Added", "Performance"}, map[string][]validateachangelog.Entry{
+					"Breaking": {
+						{Description: "Test description"},
+					},
+					"Added": {
+						{Description: "Test description"},
+					},
+					"Performance": {
+						{Description: "Test description"},
+					},
+				}),
+			},
+		},
+	}
+
+	opts := &Options{
+		AllowMissingReleaseDate: true,
+		ChangeTypes:             validateachangelog.NewChangeTypes("Breaking", "Added", "Performance", "Fixed"),
+	}
+
+	if err := Validate(c, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	// Performance must now come first
+	opts.ChangeTypes = validateachangelog.NewChangeTypes("Performance", "Breaking", "Added")
+
+	if err := Validate(c, opts); err == nil {
+		t.Fatal("Validate() should have failed with unsorted change types")
+	}
+
+	// Breaking is now unknown
+	opts.ChangeTypes = validateachangelog.NewChangeTypes("Added", "Performance")
+
+	if err := Validate(c, opts); err == nil {
+		t.Fatal("Validate() should have failed with invalid change type")
+	}
+}
+
+func TestValidateChangelogIssuePosition(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version:     "1.0.0",
+				ReleaseDate: nil,
+				Position:    validateachangelog.Position{Line: 3, Column: 1},
+				Entries: *internal.NewSortedMap([]string{"Removed", "Added"}, map[string][]validateachangelog.Entry{
+					"Removed": {
+						{Description: "Test description"},
+					},
+					"Added": {
+						{Description: "Test description"},
+					},
+				}),
+				SectionPositions: map[string]validateachangelog.Position{
+					"Removed": {Line: 5, Column: 1},
+					"Added":   {Line: 9, Column: 1},
+				},
+			},
+		},
+	}
+
+	err := Validate(c, &Options{
+		AllowMissingReleaseDate:     false,
+		AllowEmptyVersion:           true,
+		AllowInvalidChangeType:      true,
+		AllowInvalidChangeTypeOrder: false,
+	})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues. Got: %d", len(issues))
+	}
+
+	if issues[0].Line != 3 || issues[0].Column != 1 {
+		t.Logf("Expected missing release date issue at 3:1. Got: %d:%d", issues[0].Line, issues[0].Column)
+		t.Fail()
+	}
+	if issues[1].Line != 9 || issues[1].Section != "Added" {
+		t.Logf("Expected unsorted change type issue at line 9. Got: %d (%s)", issues[1].Line, issues[1].Section)
+		t.Fail()
+	}
+}
+
+func TestValidateChangelogEmptyNestedEntry(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version:     "1.0.0",
+				ReleaseDate: nil,
+				Entries: *internal.NewSortedMap([]string{"Added"}, map[string][]validateachangelog.Entry{
+					"Added": {
+						{
+							Description: "Test description",
+							Children: []validateachangelog.Entry{
+								{Description: "", Position: validateachangelog.Position{Line: 8, Column: 3}},
+							},
+						},
+					},
+				}),
+			},
+		},
+	}
+
+	err := Validate(c, &Options{
+		AllowMissingReleaseDate:     true,
+		AllowEmptyVersion:           true,
+		AllowInvalidChangeType:      true,
+		AllowInvalidChangeTypeOrder: true,
+	})
+	if err == nil {
+		t.Fatal()
+	}
+
+	issues := err.(*ValidationError).Issues
+	if len(issues) != 1 || issues[0].Line != 8 || issues[0].Section != "Added" {
+		t.Fatalf("Expected 1 empty entry issue at line 8. Got: %v", issues)
+	}
+}
+
+func TestValidateChangelogGoodVersionOrderWithPreRelease(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "Unreleased",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+			{
+				Version: "2.0.0",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+			{
+				Version: "2.0.0-rc.10",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+			{
+				Version: "2.0.0-rc.2",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+			{
+				Version: "2.0.0-beta.1",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+			{
+				Version: "1.0.0",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+		},
+	}
+
+	if err := Validate(c, &Options{
+		AllowMissingReleaseDate:     true,
+		AllowEmptyVersion:           true,
+		AllowInvalidChangeType:      true,
+		AllowInvalidChangeTypeOrder: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateChangelogBadVersionOrderWithPreRelease(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "2.0.0-rc.1",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+			{
+				Version: "2.0.0",
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+		},
+	}
+
+	if err := Validate(c, &Options{
+		AllowMissingReleaseDate:     true,
+		AllowEmptyVersion:           true,
+		AllowInvalidChangeType:      true,
+		AllowInvalidChangeTypeOrder: true,
+	}); err == nil {
+		t.Fail()
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		A      string
+		B      string
+		Result int
+	}{
+		{A: "Unreleased", B: "1.0.0", Result: 1},
+		{A: "1.0.0", B: "Unreleased", Result: -1},
+		{A: "Unreleased", B: "Unreleased", Result: 0},
+		{A: "1.0.0", B: "1.0.0-rc.1", Result: 1},
+		{A: "1.0.0-rc.2", B: "1.0.0-rc.10", Result: -1},
+		{A: "1.0.0-alpha", B: "1.0.0-alpha.1", Result: -1},
+		{A: "1.0.0+build.1", B: "1.0.0+build.2", Result: 0},
+	}
+
+	for _, c := range cases {
+		if result := compareVersions(c.A, c.B); result != c.Result {
+			t.Logf("compareVersions(%s, %s). Got %d, wanted %d", c.A, c.B, result, c.Result)
+			t.Fail()
+		}
+	}
+}
+
+func TestValidateChangelogYankedVersion(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "1.0.0",
+				Yanked:  true,
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+		},
+	}
+
+	if err := Validate(c, &Options{
+		AllowMissingReleaseDate:     true,
+		AllowEmptyVersion:           true,
+		AllowInvalidChangeType:      true,
+		AllowInvalidChangeTypeOrder: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateChangelogYankedUnreleasedVersion(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "Unreleased",
+				Yanked:  true,
+				Entries: *internal.NewEmptyMap[string, []validateachangelog.Entry](),
+			},
+		},
+	}
+
+	if err := Validate(c, &Options{
+		AllowMissingReleaseDate:     true,
+		AllowEmptyVersion:           true,
+		AllowInvalidChangeType:      true,
+		AllowInvalidChangeTypeOrder: true,
+	}); err == nil {
+		t.Fail()
+	}
+}
@@ -0,0 +1,230 @@
+package writer
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/vold-lu/validate-a-changelog"
+)
+
+const unreleasedVersion = "Unreleased"
+
+type Options struct {
+	// SortSections sorts the sections in the change types order instead of keeping their original order
+	SortSections bool
+	// ChangeTypes contains the change types order (validateachangelog.DefaultChangeTypes when nil)
+	ChangeTypes validateachangelog.ChangeTypes
+}
+
+// Write serializes the changelog as Keep a Changelog Markdown, the sections and notes being kept in place
+func Write(w io.Writer, c *validateachangelog.Changelog, opts *Options) error {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	var bb bytes.Buffer
+
+	directives := newDirectiveQueue(c)
+
+	// Handle title (if any)
+	if c.Title != "" {
+		directives.writeBefore(&bb, c.TitlePosition)
+		bb.WriteString("# " + c.Title + "\n\n")
+	}
+
+	// Handle description (if any)
+	if c.Description != "" {
+		bb.WriteString(c.Description + "\n\n")
+	}
+
+	for _, v := range c.Versions {
+		writeVersion(&bb, v, opts, directives)
+	}
+
+	writeLinks(&bb, c, directives)
+
+	// Handle directives following the last line
+	directives.writeAll(&bb)
+
+	// Make sure the file ends with a single new line
+	output := strings.TrimRight(bb.String(), "\n") + "\n"
+
+	_, err := io.WriteString(w, output)
+	return err
+}
+
+// WriteFile serializes the changelog into the given file (created or truncated)
+func WriteFile(filename string, c *validateachangelog.Changelog, opts *Options) error {
+	var bb bytes.Buffer
+	if err := Write(&bb, c, opts); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, bb.Bytes(), 0644)
+}
+
+func writeVersion(bb *bytes.Buffer, v *validateachangelog.Version, opts *Options, directives *directiveQueue) {
+	// Handle version line
+	directives.writeBefore(bb, v.Position)
+	bb.WriteString("## [" + v.Version + "]")
+
+	if v.ReleaseDate != nil && v.Version != unreleasedVersion {
+		bb.WriteString(" - " + v.ReleaseDate.Format("2006-01-02"))
+	}
+
+	if v.Yanked {
+		bb.WriteString(" [YANKED]")
+	}
+
+	bb.WriteString("\n\n")
+
+	// Handle notes (if any)
+	if v.Notes != "" {
+		bb.WriteString(v.Notes + "\n\n")
+	}
+
+	// Handle sections
+	for _, changeType := range sortChangeTypes(v.Entries.Keys(), opts) {
+		entries, _ := v.Entries.Get(changeType)
+
+		directives.writeBefore(bb, v.SectionPositions[changeType])
+		bb.WriteString("### " + changeType + "\n\n")
+
+		if len(entries) > 0 {
+			writeEntries(bb, entries, 0, directives)
+			bb.WriteString("\n")
+		}
+
+		// Handle section notes (if any)
+		if notes := v.SectionNotes[changeType]; notes != "" {
+			bb.WriteString(notes + "\n\n")
+		}
+	}
+}
+
+func writeEntries(bb *bytes.Buffer, entries []validateachangelog.Entry, depth int, directives *directiveQueue) {
+	indentation := strings.Repeat("  ", depth)
+
+	for _, entry := range entries {
+		// Indent multi-line descriptions so they are parsed as continuation lines
+		description := strings.ReplaceAll(strings.TrimSpace(entry.Description), "\n", "\n"+indentation+"  ")
+
+		directives.writeBefore(bb, entry.Position)
+		bb.WriteString(indentation + "- " + description + "\n")
+
+		// Handle nested entries
+		writeEntries(bb, entry.Children, depth+1, directives)
+	}
+}
+
+func writeLinks(bb *bytes.Buffer, c *validateachangelog.Changelog, directives *directiveQueue) {
+	for _, label := range c.Links.Keys() {
+		url, _ := c.Links.Get(label)
+
+		directives.writeBefore(bb, c.LinkPositions[label])
+		bb.WriteString("[" + label + "]: " + url + "\n")
+	}
+
+	// Handle version URL without link reference definition
+	for _, v := range c.Versions {
+		if _, exists := c.GetLink(v.Version); !exists && v.URL != "" {
+			bb.WriteString("[" + v.Version + "]: " + v.URL + "\n")
+		}
+	}
+}
+
+// directiveQueue contains the directives not written yet, each one being written before its target line
+type directiveQueue struct {
+	directives []validateachangelog.Directive
+	// lines contains the lines of the changelog nodes (title, versions, sections, entries and links)
+	lines map[int]bool
+}
+
+func newDirectiveQueue(c *validateachangelog.Changelog) *directiveQueue {
+	q := &directiveQueue{directives: c.Directives, lines: map[int]bool{c.TitlePosition.Line: true}}
+
+	for _, v := range c.Versions {
+		q.lines[v.Position.Line] = true
+
+		for _, changeType := range v.Entries.Keys() {
+			entries, _ := v.Entries.Get(changeType)
+
+			q.lines[v.SectionPositions[changeType].Line] = true
+			validateachangelog.WalkEntries(entries, func(entry *validateachangelog.Entry, _ int) {
+				q.lines[entry.Position.Line] = true
+			})
+		}
+	}
+
+	for _, position := range c.LinkPositions {
+		q.lines[position.Line] = true
+	}
+
+	return q
+}
+
+// writeBefore writes the directives targeting the node at the position, as well as the ones targeting a preceding
+// line which is not a node (e.g. version notes)
+func (q *directiveQueue) writeBefore(bb *bytes.Buffer, position validateachangelog.Position) {
+	if position.Line == 0 || len(q.directives) == 0 {
+		return
+	}
+
+	var remaining []validateachangelog.Directive
+
+	for _, directive := range q.directives {
+		line := directive.Target.Line
+
+		if line == position.Line || (line != 0 && line < position.Line && !q.lines[line]) {
+			writeDirective(bb, directive)
+		} else {
+			remaining = append(remaining, directive)
+		}
+	}
+
+	q.directives = remaining
+}
+
+// writeAll writes the remaining directives
+func (q *directiveQueue) writeAll(bb *bytes.Buffer) {
+	for _, directive := range q.directives {
+		writeDirective(bb, directive)
+	}
+
+	q.directives = nil
+}
+
+func writeDirective(bb *bytes.Buffer, directive validateachangelog.Directive) {
+	bb.WriteString("<!-- changelog-" + directive.Action)
+
+	if len(directive.Rules) > 0 {
+		bb.WriteString(" " + strings.Join(directive.Rules, ", "))
+	}
+
+	bb.WriteString(" -->\n")
+}
+
+// sortChangeTypes sorts the change types by their weight (if Options.SortSections), unknown change types are kept at
+// the end in their original order
+func sortChangeTypes(changeTypes []string, opts *Options) []string {
+	sorted := make([]string, len(changeTypes))
+	copy(sorted, changeTypes)
+
+	if !opts.SortSections {
+		return sorted
+	}
+
+	weights := opts.ChangeTypes
+	if weights == nil {
+		weights = validateachangelog.DefaultChangeTypes()
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return weights.Weight(sorted[i]) < weights.Weight(sorted[j])
+	})
+
+	return sorted
+}
@@ -0,0 +1,248 @@
+package writer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vold-lu/validate-a-changelog"
+	"github.com/vold-lu/validate-a-changelog/internal"
+	"github.com/vold-lu/validate-a-changelog/parser"
+)
+
+const canonicalChangelog = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),
+and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).
+
+## [Unreleased]
+
+### Added
+
+- Translations:
+  - German.
+  - French.
+    - Swiss French.
+
+### Performance
+
+- Faster parsing.
+
+## [2.0.0] - 2025-10-28
+
+This release contains breaking changes.
+
+#### Upgrade notes
+
+Run the migration.
+
+### Removed
+
+- Legacy API.
+
+## [1.0.0-rc.1] - 2025-10-27 [YANKED]
+
+### Fixed
+
+- Wrapped entry which was split over multiple lines.
+
+[unreleased]: https://example.org/compare/v2.0.0...HEAD
+[2.0.0]: https://example.org/compare/v1.0.0-rc.1...v2.0.0
+[1.0.0-rc.1]: https://example.org/releases/tag/v1.0.0-rc.1
+`
+
+func TestWriteCanonicalChangelog(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader(canonicalChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bb bytes.Buffer
+	if err := Write(&bb, c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if bb.String() != canonicalChangelog {
+		t.Fatalf("Expected canonical changelog to be written as is. Got:\n%s", bb.String())
+	}
+}
+
+func TestWriteIsStable(t *testing.T) {
+	input := "# Changelog\n\n## [Unreleased]\n### Fixed\n- Fix\n  wrapped\n    - Nested\n### Added\n- Feature\n\n\nSome notes.\n## [1.0.0] - 2025-10-28\n### Custom\n- Custom entry\n### Added\n- Initial release\n[1.0.0]: https://example.org/releases/tag/v1.0.0\n"
+
+	c, err := parser.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var first bytes.Buffer
+	if err := Write(&first, c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err = parser.Parse(bytes.NewReader(first.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var second bytes.Buffer
+	if err := Write(&second, c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if first.String() != second.String() {
+		t.Fatalf("Expected parse -> write -> parse to be stable. Got:\n%s\n---\n%s", first.String(), second.String())
+	}
+
+	// The sections and notes are kept in place
+	expected := "# Changelog\n\n## [Unreleased]\n\n### Fixed\n\n- Fix wrapped\n  - Nested\n\n### Added\n\n- Feature\n\nSome notes.\n\n## [1.0.0] - 2025-10-28\n\n### Custom\n\n- Custom entry\n\n### Added\n\n- Initial release\n\n[1.0.0]: https://example.org/releases/tag/v1.0.0\n"
+	if first.String() != expected {
+		t.Fatalf("Unexpected output:\n%s", first.String())
+	}
+}
+
+func TestWriteSortSections(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "Unreleased",
+				Entries: *internal.NewSortedMap([]string{"Custom", "Fixed", "Added"}, map[string][]validateachangelog.Entry{
+					"Custom": {{Description: "Custom entry"}},
+					"Fixed":  {{Description: "Fix"}},
+					"Added":  {{Description: "Feature"}},
+				}),
+				SectionNotes: map[string]string{"Fixed": "See the issue."},
+			},
+		},
+	}
+
+	var bb bytes.Buffer
+	if err := Write(&bb, c, &Options{SortSections: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if bb.String() != "## [Unreleased]\n\n### Added\n\n- Feature\n\n### Fixed\n\n- Fix\n\nSee the issue.\n\n### Custom\n\n- Custom entry\n" {
+		t.Fatalf("Unexpected output:\n%s", bb.String())
+	}
+}
+
+func TestWriteAddedEntryKeepsReleases(t *testing.T) {
+	input := "# Changelog\n\n## [1.0.0] - 2025-10-28\n\nUpgrade notes.\n\n### Fixed\n\n- Fix\n\nSee the issue.\n\n### Added\n\n- Initial release\n"
+
+	c, err := parser.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.AddEntry("Unreleased", "Added", "Feature"); err != nil {
+		t.Fatal(err)
+	}
+
+	var bb bytes.Buffer
+	if err := Write(&bb, c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the Unreleased version is added, the released one is written as is
+	expected := "# Changelog\n\n## [Unreleased]\n\n### Added\n\n- Feature\n\n" + strings.TrimPrefix(input, "# Changelog\n\n")
+	if bb.String() != expected {
+		t.Fatalf("Unexpected output:\n%s", bb.String())
+	}
+}
+
+func TestWriteDirectives(t *testing.T) {
+	input := "# Changelog\n\n<!-- changelog-disable CL005 -->\n## [1.0.1] - 2025-10-29\n\n<!-- changelog-enable -->\nUpgrade notes.\n\n<!-- changelog-disable-next-line unsorted-change-type -->\n### Fixed\n\n- Fix\n<!-- changelog-disable-next-line CL007 -->\n- \n\n[1.0.1]: https://example.org/releases/tag/v1.0.1\n<!-- changelog-enable -->\n"
+
+	c, err := parser.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bb bytes.Buffer
+	if err := Write(&bb, c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The directives are written before the line they precede (the notes being written after the version line)
+	expected := "# Changelog\n\n<!-- changelog-disable CL005 -->\n## [1.0.1] - 2025-10-29\n\nUpgrade notes.\n\n<!-- changelog-enable -->\n<!-- changelog-disable-next-line unsorted-change-type -->\n### Fixed\n\n- Fix\n<!-- changelog-disable-next-line CL007 -->\n- \n\n[1.0.1]: https://example.org/releases/tag/v1.0.1\n<!-- changelog-enable -->\n"
+	if bb.String() != expected {
+		t.Fatalf("Unexpected output:\n%s", bb.String())
+	}
+}
+
+func TestWriteChangeTypesOrder(t *testing.T) {
+	c := &validateachangelog.Changelog{
+		Versions: []*validateachangelog.Version{
+			{
+				Version: "Unreleased",
+				Entries: *internal.NewSortedMap([]string{"Added", "Performance", "Breaking"}, map[string][]validateachangelog.Entry{
+					"Added":       {{Description: "Feature"}},
+					"Performance": {{Description: "Faster"}},
+					"Breaking":    {{Description: "Drop option"}},
+				}),
+			},
+		},
+	}
+
+	var bb bytes.Buffer
+	if err := Write(&bb, c, &Options{SortSections: true, ChangeTypes: validateachangelog.NewChangeTypes("Breaking", "Added", "Performance")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if bb.String() != "## [Unreleased]\n\n### Breaking\n\n- Drop option\n\n### Added\n\n- Feature\n\n### Performance\n\n- Faster\n" {
+		t.Fatalf("Unexpected output:\n%s", bb.String())
+	}
+}
+
+func TestWriteVersionURLWithoutLink(t *testing.T) {
+	releaseDate := time.Date(2025, 10, 28, 0, 0, 0, 0, time.UTC)
+
+	c := &validateachangelog.Changelog{
+		Title: "Changelog",
+		Versions: []*validateachangelog.Version{
+			{
+				Version:     "1.0.0",
+				ReleaseDate: &releaseDate,
+				URL:         "https://example.org/releases/tag/v1.0.0",
+				Entries: *internal.NewSortedMap([]string{"Added"}, map[string][]validateachangelog.Entry{
+					"Added": {{Description: "Multi-line\ndescription"}},
+				}),
+			},
+		},
+	}
+
+	var bb bytes.Buffer
+	if err := Write(&bb, c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if bb.String() != "# Changelog\n\n## [1.0.0] - 2025-10-28\n\n### Added\n\n- Multi-line\n  description\n\n[1.0.0]: https://example.org/releases/tag/v1.0.0\n" {
+		t.Fatalf("Unexpected output:\n%s", bb.String())
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	c, err := parser.Parse(strings.NewReader(canonicalChangelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(t.TempDir(), "CHANGELOG.md")
+	if err := WriteFile(filename, c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != canonicalChangelog {
+		t.Fatalf("Unexpected file content:\n%s", string(b))
+	}
+}